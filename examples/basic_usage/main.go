@@ -46,7 +46,7 @@ func main() {
 
 	// 2. List all indexes
 	fmt.Println("\n📋 Listing all indexes...")
-	indexes, err := client.Indexes.List(context.Background(), map[string]string{})
+	indexes, err := client.Indexes.All(context.Background(), nil)
 	if err != nil {
 		log.Printf("Error listing indexes: %v", err)
 		return
@@ -82,7 +82,7 @@ func main() {
 		return
 	}
 
-	if completedTask.Status != "ready" {
+	if !completedTask.Status.IsSuccess() {
 		log.Printf("Task failed with status: %s", completedTask.Status)
 		return
 	}
@@ -90,8 +90,8 @@ func main() {
 
 	// 5. List tasks
 	fmt.Println("\n📝 Listing recent tasks...")
-	tasks, err := client.Tasks.List(context.Background(), map[string]string{
-		"index_id": index.ID,
+	tasks, err := client.Tasks.List(context.Background(), &models.TaskListOptions{
+		IndexID: index.ID,
 	})
 	if err != nil {
 		log.Printf("Error listing tasks: %v", err)
@@ -61,7 +61,7 @@ func main() {
 
 	// 3. Bulk task creation with mixed sources
 	fmt.Println("\n📦 Creating bulk tasks with mixed sources...")
-	bulkTasks, err := client.Tasks.CreateBulk(context.Background(), &wrappers.CreateBulkRequest{
+	bulkResults, err := client.Tasks.CreateBulk(context.Background(), &wrappers.CreateBulkRequest{
 		IndexID: indexID,
 		VideoURLs: []string{
 			"https://example.com/your-first-video.mp4",
@@ -71,22 +71,28 @@ func main() {
 		//	"./assets/local_video2.mp4",
 		//},
 		EnableVideoStream: true,
+		Workers:           4,
 	})
 	if err != nil {
-		log.Printf("Error creating bulk tasks: %v", err)
-	} else {
-		fmt.Printf("✅ Bulk tasks created: %d tasks\n", len(bulkTasks))
-		for i, task := range bulkTasks {
-			fmt.Printf("   Task %d: %s (Status: %s)\n", i+1, task.ID, task.Status)
+		log.Printf("Some bulk tasks failed: %v", err)
+	}
+	var bulkTasks []models.Task
+	for i, result := range bulkResults {
+		if result.Err != nil {
+			fmt.Printf("   ❌ Input %d (%s): %v\n", i+1, result.Source, result.Err)
+			continue
 		}
+		bulkTasks = append(bulkTasks, *result.Task)
+		fmt.Printf("   Task %d: %s (Status: %s)\n", i+1, result.Task.ID, result.Task.Status)
 	}
+	fmt.Printf("✅ Bulk tasks created: %d of %d\n", len(bulkTasks), len(bulkResults))
 
 	// 4. List and filter tasks
 	fmt.Println("\n📋 Listing and filtering tasks...")
 
 	// List all tasks for the index
-	allTasks, err := client.Tasks.List(context.Background(), map[string]string{
-		"index_id": indexID,
+	allTasks, err := client.Tasks.All(context.Background(), &models.TaskListOptions{
+		IndexID: indexID,
 	})
 	if err != nil {
 		log.Printf("Error listing tasks: %v", err)
@@ -95,9 +101,9 @@ func main() {
 	}
 
 	// List only ready tasks
-	readyTasks, err := client.Tasks.List(context.Background(), map[string]string{
-		"index_id": indexID,
-		"status":   "ready",
+	readyTasks, err := client.Tasks.All(context.Background(), &models.TaskListOptions{
+		IndexID: indexID,
+		Status:  []models.TaskStatus{models.TaskStatusReady},
 	})
 	if err != nil {
 		log.Printf("Error listing ready tasks: %v", err)
@@ -132,47 +138,34 @@ func main() {
 		}
 	}
 
-	// 6. Wait for multiple tasks in parallel
-	fmt.Println("\n🔄 Waiting for multiple tasks (first 3)...")
-	if len(bulkTasks) >= 3 {
-		tasksToWait := bulkTasks[:3]
-
-		// Channel to collect results
-		results := make(chan *models.Task, len(tasksToWait))
-		errors := make(chan error, len(tasksToWait))
-
-		// Start waiting for each task in a goroutine
-		for _, task := range tasksToWait {
-			go func(taskID string) {
-				completedTask, err := client.Tasks.WaitForDone(context.Background(), taskID, &wrappers.WaitForDoneOptions{
-					SleepInterval: 10 * time.Second,
-					Callback: func(t *models.Task) error {
-						fmt.Printf("   🔄 Task %s: %s\n", t.ID, t.Status)
-						return nil
-					},
-				})
-
-				if err != nil {
-					errors <- err
-				} else {
-					results <- completedTask
-				}
-			}(task.ID)
+	// 6. Wait for all bulk tasks concurrently
+	fmt.Printf("\n🔄 Waiting for %d bulk tasks...\n", len(bulkTasks))
+	if len(bulkTasks) > 0 {
+		taskIDs := make([]string, len(bulkTasks))
+		for i, task := range bulkTasks {
+			taskIDs[i] = task.ID
 		}
 
-		// Collect results
-		completed := 0
-		for completed < len(tasksToWait) {
-			select {
-			case task := <-results:
-				completed++
-				fmt.Printf("✅ Task %s completed (%d/%d)\n", task.ID, completed, len(tasksToWait))
-			case err := <-errors:
-				completed++
-				fmt.Printf("❌ Task failed: %v (%d/%d)\n", err, completed, len(tasksToWait))
-			case <-time.After(5 * time.Minute): // Timeout after 5 minutes
-				fmt.Println("⏰ Timeout waiting for tasks")
-				return
+		events := make(chan wrappers.TaskEvent)
+		go func() {
+			for event := range events {
+				fmt.Printf("   🔄 Task %s: %s\n", event.TaskID, event.Task.Status)
+			}
+		}()
+
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Minute)
+		defer cancel()
+		waitResults, err := client.Tasks.WaitForAll(ctx, taskIDs, &wrappers.WaitForManyOptions{
+			PollInterval: 10 * time.Second,
+			Events:       events,
+		})
+		close(events)
+		if err != nil {
+			log.Printf("Some tasks could not be followed: %v", err)
+		}
+		for _, taskID := range taskIDs {
+			if result := waitResults[taskID]; result.Err == nil {
+				fmt.Printf("✅ Task %s finished with status: %s\n", taskID, result.Task.Status)
 			}
 		}
 	}
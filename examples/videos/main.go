@@ -26,7 +26,7 @@ func main() {
 
 	// 1. List all videos in an index
 	fmt.Println("\n📋 Listing all videos in index...")
-	allVideos, err := client.Indexes.Videos.List(context.Background(), indexID, map[string]string{})
+	allVideos, err := client.Indexes.Videos.All(context.Background(), indexID, nil)
 	if err != nil {
 		log.Printf("Error listing videos: %v", err)
 		return
@@ -50,10 +50,10 @@ func main() {
 
 	// 2. List videos with pagination
 	fmt.Println("\n📄 Listing videos with pagination...")
-	paginatedVideos, err := client.Indexes.Videos.List(context.Background(), indexID, map[string]string{
-		"page_limit": "5",
-		"sort_by":    "created_at",
-		"sort_order": "desc",
+	paginatedVideos, err := client.Indexes.Videos.List(context.Background(), indexID, &models.VideoListOptions{
+		PageLimit:  5,
+		SortBy:     "created_at",
+		SortOption: "desc",
 	})
 	if err != nil {
 		log.Printf("Error listing paginated videos: %v", err)
@@ -1,3 +1,9 @@
 module github.com/favourthemaster/twelvelabs-go-sdk
 
 go 1.24
+
+require (
+	go.opentelemetry.io/otel v1.35.0
+	go.opentelemetry.io/otel/metric v1.35.0
+	go.opentelemetry.io/otel/trace v1.35.0
+)
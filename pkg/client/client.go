@@ -6,12 +6,18 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
-	"log"
+	"log/slog"
 	"net/http"
+	"strings"
 	"time"
 
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/trace"
+
 	"github.com/favourthemaster/twelvelabs-go-sdk/pkg/errors"
 	"github.com/favourthemaster/twelvelabs-go-sdk/pkg/services"
+	"github.com/favourthemaster/twelvelabs-go-sdk/pkg/telemetry"
 )
 
 const (
@@ -28,12 +34,43 @@ type Client struct {
 	Embed      *services.EmbedService
 	Search     *services.SearchService
 	Analyze    *services.AnalyzeService
+	Uploads    *services.UploadsService
+	// Retry is the retry policy applied by Do and DoRaw. A nil policy disables retries.
+	Retry *RetryPolicy
+
+	// external sends requests to URLs outside the API, bypassing the middlewares.
+	external  *http.Client
+	limiters  map[EndpointFamily]*limiter
+	logger    *slog.Logger
+	httpLog   HTTPLogMode
+	telemetry *telemetry.Instrumentation
 }
 
 type Options struct {
 	BaseURL string
 	APIKey  string
 	Timeout time.Duration
+	// Retry configures automatic retries with exponential backoff.
+	// If nil, every request is attempted exactly once.
+	Retry *RetryPolicy
+	// RateLimits throttles requests per endpoint family. Families without an entry
+	// are not limited. The limits are shared by every service of the client.
+	RateLimits map[EndpointFamily]RateLimit
+	// HTTPClient is used to send requests. It is copied, never modified.
+	// If nil, a new http.Client with Timeout is created.
+	HTTPClient *http.Client
+	// Transport replaces the transport of HTTPClient, e.g. to route through a corporate proxy.
+	Transport http.RoundTripper
+	// Middlewares wrap the transport in order; the first middleware is the outermost.
+	Middlewares []Middleware
+	// Logger receives the SDK's diagnostics. If nil, nothing is logged.
+	Logger *slog.Logger
+	// HTTPLog selects how much of each request and response is logged at debug level.
+	HTTPLog HTTPLogMode
+	// TracerProvider enables OpenTelemetry spans for every API call. If nil, no spans are created.
+	TracerProvider trace.TracerProvider
+	// MeterProvider enables OpenTelemetry request metrics. If nil, no metrics are recorded.
+	MeterProvider metric.MeterProvider
 }
 
 func NewClient(options *Options) *Client {
@@ -44,11 +81,37 @@ func NewClient(options *Options) *Client {
 	httpClient := &http.Client{
 		Timeout: options.Timeout,
 	}
+	if options.HTTPClient != nil {
+		clientCopy := *options.HTTPClient
+		httpClient = &clientCopy
+		if options.Timeout > 0 {
+			httpClient.Timeout = options.Timeout
+		}
+	}
+	if options.Transport != nil {
+		httpClient.Transport = options.Transport
+	}
+	externalClient := *httpClient
+	if len(options.Middlewares) > 0 {
+		httpClient.Transport = Chain(httpClient.Transport, options.Middlewares...)
+	}
 
 	client := &Client{
 		HTTPClient: httpClient,
 		BaseURL:    options.BaseURL,
 		APIKey:     options.APIKey,
+		Retry:      options.Retry,
+		external:   &externalClient,
+		limiters:   make(map[EndpointFamily]*limiter, len(options.RateLimits)),
+		logger:     options.Logger,
+		httpLog:    options.HTTPLog,
+		telemetry:  telemetry.New(options.TracerProvider, options.MeterProvider),
+	}
+	if client.logger == nil {
+		client.logger = discardLogger
+	}
+	for family, limit := range options.RateLimits {
+		client.limiters[family] = newLimiter(limit)
 	}
 
 	// Initialize services with client reference
@@ -57,6 +120,7 @@ func NewClient(options *Options) *Client {
 	client.Embed = &services.EmbedService{Client: client}
 	client.Search = &services.SearchService{Client: client}
 	client.Analyze = &services.AnalyzeService{Client: client}
+	client.Uploads = &services.UploadsService{Client: client}
 
 	return client
 }
@@ -91,15 +155,18 @@ func (c *Client) NewRequest(ctx context.Context, method, path string, body inter
 	return req, nil
 }
 
-func (c *Client) Do(req *http.Request, v interface{}) (*http.Response, error) {
-	res, err := c.HTTPClient.Do(req)
+func (c *Client) Do(req *http.Request, v interface{}) (res *http.Response, err error) {
+	req, rt := c.startRequest(req)
+	defer func() { c.endRequest(rt, err) }()
+
+	res, err = c.send(req, rt)
 	if err != nil {
 		return nil, err
 	}
 	defer func(Body io.ReadCloser) {
 		err := Body.Close()
 		if err != nil {
-			log.Fatalf("failed to close response body: %v", err)
+			c.logger.Warn("twelvelabs: failed to close response body", slog.Any("error", err))
 		}
 	}(res.Body)
 
@@ -110,13 +177,15 @@ func (c *Client) Do(req *http.Request, v interface{}) (*http.Response, error) {
 	}
 
 	if res.StatusCode >= 400 {
-		return nil, handleAPIError(res.StatusCode, body)
+		return nil, handleAPIError(res, body)
 	}
 
 	if v != nil && len(body) > 0 {
 		if err := json.Unmarshal(body, v); err != nil {
-			fmt.Printf("DEBUG: JSON unmarshal error: %v\n", err)
-			fmt.Printf("DEBUG: Trying to unmarshal into type: %T\n", v)
+			c.logger.Debug("twelvelabs: failed to unmarshal response",
+				slog.String("url", req.URL.Redacted()),
+				slog.String("target_type", fmt.Sprintf("%T", v)),
+				slog.Any("error", err))
 			return nil, fmt.Errorf("failed to unmarshal JSON response: %w", err)
 		}
 	}
@@ -126,55 +195,208 @@ func (c *Client) Do(req *http.Request, v interface{}) (*http.Response, error) {
 
 // DoRaw performs a raw HTTP request and returns the response without closing the body
 // This is useful for streaming responses where the caller needs to handle the response body
-func (c *Client) DoRaw(req *http.Request) (*http.Response, error) {
-	res, err := c.HTTPClient.Do(req)
+func (c *Client) DoRaw(req *http.Request) (res *http.Response, err error) {
+	req, rt := c.startRequest(req)
+	defer func() { c.endRequest(rt, err) }()
+
+	res, err = c.send(req, rt)
+	if err != nil {
+		return nil, err
+	}
+
+	if res.StatusCode >= 400 {
+		defer func(Body io.ReadCloser) {
+			err := Body.Close()
+			if err != nil {
+				c.logger.Warn("twelvelabs: failed to close error response body", slog.Any("error", err))
+			}
+		}(res.Body)
+		body, err := io.ReadAll(res.Body)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read error response body: %w", err)
+		}
+		return nil, handleAPIError(res, body)
+	}
+
+	return res, nil
+}
+
+// DoExternal sends a request to a URL outside the API, such as a pre-signed upload
+// URL, and returns the response without closing the body. The request goes out
+// as built by the caller: the API key is never attached and it bypasses the
+// middlewares, the retry policy and the rate limits of the API.
+func (c *Client) DoExternal(req *http.Request) (res *http.Response, err error) {
+	req, rt := c.startRequest(req)
+	defer func() { c.endRequest(rt, err) }()
+
+	req.Header.Del("X-API-KEY")
+	rt.attempts++
+	c.logRequest(req)
+	start := time.Now()
+	res, err = c.external.Do(req)
+	c.logResponse(req.Context(), req, res, err, time.Since(start))
 	if err != nil {
 		return nil, err
 	}
+	rt.statusCode = res.StatusCode
 
 	if res.StatusCode >= 400 {
 		defer func(Body io.ReadCloser) {
 			err := Body.Close()
 			if err != nil {
-				log.Printf("failed to close error response body: %v", err)
+				c.logger.Warn("twelvelabs: failed to close error response body", slog.Any("error", err))
 			}
 		}(res.Body)
 		body, err := io.ReadAll(res.Body)
 		if err != nil {
 			return nil, fmt.Errorf("failed to read error response body: %w", err)
 		}
-		return nil, handleAPIError(res.StatusCode, body)
+		return nil, handleAPIError(res, body)
+	}
+
+	return res, nil
+}
+
+// send executes req, retrying according to the client's retry policy.
+// The returned response is the outcome of the last attempt.
+func (c *Client) send(req *http.Request, rt *requestTrace) (*http.Response, error) {
+	return c.Retry.do(req, func(req *http.Request) (*http.Response, error) {
+		rt.attempts++
+		res, err := c.attempt(req)
+		if res != nil {
+			rt.statusCode = res.StatusCode
+		}
+		return res, err
+	}, c.logger, c.logURL)
+}
+
+// requestTrace carries the telemetry state of a single Do or DoRaw call.
+type requestTrace struct {
+	ctx        context.Context
+	span       trace.Span
+	start      time.Time
+	method     string
+	endpoint   string
+	uploadSize int64
+	attempts   int
+	statusCode int
+}
+
+// startRequest opens a client span for req and returns req bound to the span's context.
+func (c *Client) startRequest(req *http.Request) (*http.Request, *requestTrace) {
+	endpoint, indexID, videoID := telemetry.Endpoint(req.URL.Path)
+	if c.isExternal(req) {
+		endpoint, indexID, videoID = telemetry.ExternalEndpoint, "", ""
+	}
+	var attrs []attribute.KeyValue
+	if indexID != "" {
+		attrs = append(attrs, telemetry.AttrIndexID.String(indexID))
+	}
+	if videoID != "" {
+		attrs = append(attrs, telemetry.AttrVideoID.String(videoID))
 	}
+	ctx, span := c.telemetry.StartRequest(req.Context(), req.Method, endpoint, attrs...)
+	rt := &requestTrace{
+		ctx:        ctx,
+		span:       span,
+		start:      time.Now(),
+		method:     req.Method,
+		endpoint:   endpoint,
+		uploadSize: max(req.ContentLength, 0),
+	}
+	return req.WithContext(ctx), rt
+}
 
+// endRequest records the outcome of a Do or DoRaw call and ends its span.
+func (c *Client) endRequest(rt *requestTrace, err error) {
+	c.telemetry.EndRequest(rt.ctx, rt.span, telemetry.RequestResult{
+		Method:      rt.method,
+		Endpoint:    rt.endpoint,
+		StatusCode:  rt.statusCode,
+		Retries:     max(rt.attempts-1, 0),
+		UploadBytes: rt.uploadSize,
+		Duration:    time.Since(rt.start),
+		Err:         err,
+	})
+}
+
+// attempt sends req once, waiting for the rate limiter of its endpoint family first.
+func (c *Client) attempt(req *http.Request) (*http.Response, error) {
+	l := c.limiters[EndpointFamilyOf(req.URL.Path)]
+	if l == nil {
+		return c.roundTrip(req)
+	}
+
+	release, err := l.acquire(req.Context())
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.roundTrip(req)
+	if err != nil {
+		release()
+		return nil, err
+	}
+	l.observe(res, time.Now())
+	res.Body = &releaseOnClose{ReadCloser: res.Body, release: release}
 	return res, nil
 }
 
-func handleAPIError(statusCode int, body []byte) error {
+// roundTrip sends req with the underlying HTTP client and logs the exchange.
+func (c *Client) roundTrip(req *http.Request) (*http.Response, error) {
+	c.logRequest(req)
+	start := time.Now()
+	res, err := c.HTTPClient.Do(req)
+	c.logResponse(req.Context(), req, res, err, time.Since(start))
+	return res, err
+}
+
+// isExternal reports whether req targets a URL outside the API, such as a
+// pre-signed upload URL returned by the API.
+func (c *Client) isExternal(req *http.Request) bool {
+	return !strings.HasPrefix(req.URL.String(), c.BaseURL)
+}
+
+// Telemetry returns the OpenTelemetry instrumentation of the client. It is never nil.
+func (c *Client) Telemetry() *telemetry.Instrumentation {
+	return c.telemetry
+}
+
+// Logger returns the logger used for the SDK's diagnostics. It is never nil.
+func (c *Client) Logger() *slog.Logger {
+	return c.logger
+}
+
+// requestIDHeaders lists the response headers that may carry the API request ID.
+var requestIDHeaders = []string{"X-Request-Id", "Request-Id", "X-Amzn-Requestid"}
+
+func handleAPIError(res *http.Response, body []byte) error {
 	apiErr := &errors.APIError{
-		StatusCode: statusCode,
+		StatusCode: res.StatusCode,
+		RawBody:    body,
 	}
 
 	var errBody struct {
+		Code    string `json:"code"`
 		Message string `json:"message"`
+		DocsURL string `json:"docs_url"`
 	}
-	if err := json.Unmarshal(body, &errBody); err == nil {
+	if err := json.Unmarshal(body, &errBody); err == nil && errBody.Message != "" {
 		apiErr.Message = errBody.Message
+		apiErr.Code = errBody.Code
+		apiErr.DocsURL = errBody.DocsURL
 	} else {
-		apiErr.Message = fmt.Sprintf("HTTP %d", statusCode)
-	}
-
-	switch statusCode {
-	case http.StatusBadRequest:
-		return &errors.BadRequestError{APIError: *apiErr}
-	case http.StatusUnauthorized:
-		return &errors.UnauthorizedError{APIError: *apiErr}
-	case http.StatusNotFound:
-		return &errors.NotFoundError{APIError: *apiErr}
-	case http.StatusTooManyRequests:
-		return &errors.TooManyRequestsError{APIError: *apiErr}
-	case http.StatusInternalServerError:
-		return &errors.InternalServerError{APIError: *apiErr}
-	default:
-		return apiErr
+		apiErr.Message = fmt.Sprintf("HTTP %d", res.StatusCode)
+		if text := http.StatusText(res.StatusCode); text != "" {
+			apiErr.Message += " " + text
+		}
 	}
+
+	for _, header := range requestIDHeaders {
+		if id := res.Header.Get(header); id != "" {
+			apiErr.RequestID = id
+			break
+		}
+	}
+
+	return errors.FromAPIError(apiErr)
 }
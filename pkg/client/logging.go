@@ -0,0 +1,102 @@
+package client
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// HTTPLogMode selects how much of the HTTP traffic the client logs.
+// All HTTP traffic is logged at slog.LevelDebug.
+type HTTPLogMode int
+
+const (
+	// HTTPLogOff disables request and response logging.
+	HTTPLogOff HTTPLogMode = iota
+	// HTTPLogBasic logs method, URL, status code and duration.
+	HTTPLogBasic
+	// HTTPLogHeaders additionally logs request and response headers with secrets redacted.
+	HTTPLogHeaders
+)
+
+const redacted = "[REDACTED]"
+
+// sensitiveHeaders are never written to logs.
+var sensitiveHeaders = []string{
+	"X-Api-Key",
+	"Authorization",
+	"Proxy-Authorization",
+	"Cookie",
+	"Set-Cookie",
+}
+
+// discardLogger is used when no logger is configured, so the SDK writes nothing by default.
+var discardLogger = slog.New(slog.DiscardHandler)
+
+// RedactHeaders returns a copy of h with credentials such as X-API-KEY replaced by a placeholder.
+func RedactHeaders(h http.Header) http.Header {
+	out := h.Clone()
+	for _, key := range sensitiveHeaders {
+		if _, ok := out[key]; ok {
+			out[key] = []string{redacted}
+		}
+	}
+	return out
+}
+
+// logRequest logs an outgoing request attempt according to the client's log mode.
+func (c *Client) logRequest(req *http.Request) {
+	ctx := req.Context()
+	if c.httpLog == HTTPLogOff || !c.logger.Enabled(ctx, slog.LevelDebug) {
+		return
+	}
+	attrs := []slog.Attr{
+		slog.String("method", req.Method),
+		slog.String("url", c.logURL(req)),
+	}
+	if c.httpLog >= HTTPLogHeaders {
+		attrs = append(attrs, slog.Any("headers", RedactHeaders(req.Header)))
+	}
+	c.logger.LogAttrs(ctx, slog.LevelDebug, "twelvelabs: sending request", attrs...)
+}
+
+// logURL returns the URL of req for logging. The query of external URLs is
+// dropped because pre-signed URLs carry their credentials there.
+func (c *Client) logURL(req *http.Request) string {
+	return redactURL(req.URL, c.isExternal(req))
+}
+
+// redactURL returns u for logging with its password redacted and, when dropQuery
+// is set, without its query.
+func redactURL(u *url.URL, dropQuery bool) string {
+	if !dropQuery {
+		return u.Redacted()
+	}
+	stripped := *u
+	stripped.RawQuery = ""
+	return stripped.Redacted()
+}
+
+// logResponse logs the outcome of a request attempt according to the client's log mode.
+func (c *Client) logResponse(ctx context.Context, req *http.Request, res *http.Response, err error, elapsed time.Duration) {
+	if c.httpLog == HTTPLogOff || !c.logger.Enabled(ctx, slog.LevelDebug) {
+		return
+	}
+	attrs := []slog.Attr{
+		slog.String("method", req.Method),
+		slog.String("url", c.logURL(req)),
+		slog.Duration("duration", elapsed),
+	}
+	if err != nil {
+		attrs = append(attrs, slog.Any("error", err))
+		c.logger.LogAttrs(ctx, slog.LevelDebug, "twelvelabs: request failed", attrs...)
+		return
+	}
+	attrs = append(attrs, slog.Int("status", res.StatusCode))
+	if c.httpLog >= HTTPLogHeaders {
+		attrs = append(attrs, slog.Any("headers", RedactHeaders(res.Header)))
+	}
+	c.logger.LogAttrs(ctx, slog.LevelDebug, "twelvelabs: received response", attrs...)
+}
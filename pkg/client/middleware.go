@@ -0,0 +1,111 @@
+package client
+
+import (
+	"log/slog"
+	"net/http"
+	"net/http/httptrace"
+	"time"
+)
+
+// Middleware wraps the transport used by the client. Middlewares see every
+// attempt of every request sent through Do and DoRaw, in the order they are
+// listed in Options.Middlewares: the first middleware is the outermost one.
+// Requests to pre-signed storage URLs, sent through DoExternal, bypass them.
+//
+// As with any http.RoundTripper, a middleware must not modify the request it
+// receives; clone it first with req.Clone.
+type Middleware func(next http.RoundTripper) http.RoundTripper
+
+// RoundTripperFunc adapts an ordinary function to the http.RoundTripper interface.
+type RoundTripperFunc func(*http.Request) (*http.Response, error)
+
+// RoundTrip calls f(req).
+func (f RoundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+// Chain wraps base with the given middlewares. The first middleware is the outermost.
+// If base is nil, http.DefaultTransport is used.
+func Chain(base http.RoundTripper, middlewares ...Middleware) http.RoundTripper {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	for i := len(middlewares) - 1; i >= 0; i-- {
+		if middlewares[i] != nil {
+			base = middlewares[i](base)
+		}
+	}
+	return base
+}
+
+// HeadersMiddleware sets the given headers on every request, replacing existing values.
+// It is useful for proxy authentication or correlation headers.
+func HeadersMiddleware(headers http.Header) Middleware {
+	return func(next http.RoundTripper) http.RoundTripper {
+		return RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			req = req.Clone(req.Context())
+			for key, values := range headers {
+				req.Header.Del(key)
+				for _, value := range values {
+					req.Header.Add(key, value)
+				}
+			}
+			return next.RoundTrip(req)
+		})
+	}
+}
+
+// LoggingMiddleware logs each request attempt and its outcome at the given level.
+// URLs are logged without their query: a middleware cannot tell API URLs from
+// pre-signed URLs, which carry their credentials there.
+func LoggingMiddleware(logger *slog.Logger, level slog.Level) Middleware {
+	return func(next http.RoundTripper) http.RoundTripper {
+		return RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			ctx := req.Context()
+			if !logger.Enabled(ctx, level) {
+				return next.RoundTrip(req)
+			}
+
+			start := time.Now()
+			res, err := next.RoundTrip(req)
+			attrs := []slog.Attr{
+				slog.String("method", req.Method),
+				slog.String("url", redactURL(req.URL, true)),
+				slog.Duration("duration", time.Since(start)),
+			}
+			if err != nil {
+				logger.LogAttrs(ctx, level, "twelvelabs: request failed", append(attrs, slog.Any("error", err))...)
+				return res, err
+			}
+			logger.LogAttrs(ctx, level, "twelvelabs: request completed", append(attrs, slog.Int("status", res.StatusCode))...)
+			return res, nil
+		})
+	}
+}
+
+// RetryMiddleware retries requests at the transport level according to policy.
+// It is an alternative to Options.Retry for callers composing their own transport.
+func RetryMiddleware(policy *RetryPolicy) Middleware {
+	return func(next http.RoundTripper) http.RoundTripper {
+		return RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			return policy.do(req, next.RoundTrip, discardLogger, func(req *http.Request) string {
+				return redactURL(req.URL, true)
+			})
+		})
+	}
+}
+
+// TracingMiddleware attaches the httptrace.ClientTrace returned by trace to every request,
+// exposing DNS, connection and TLS events. trace may return nil to skip a request.
+func TracingMiddleware(trace func(*http.Request) *httptrace.ClientTrace) Middleware {
+	return func(next http.RoundTripper) http.RoundTripper {
+		return RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			clientTrace := trace(req)
+			if clientTrace == nil {
+				return next.RoundTrip(req)
+			}
+			ctx := httptrace.WithClientTrace(req.Context(), clientTrace)
+			return next.RoundTrip(req.WithContext(ctx))
+		})
+	}
+}
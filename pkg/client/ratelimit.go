@@ -0,0 +1,192 @@
+package client
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// EndpointFamily groups API endpoints that share a rate limit on the server side.
+type EndpointFamily string
+
+const (
+	FamilySearch  EndpointFamily = "search"
+	FamilyAnalyze EndpointFamily = "analyze"
+	FamilyTasks   EndpointFamily = "tasks"
+	FamilyEmbed   EndpointFamily = "embed"
+	FamilyIndexes EndpointFamily = "indexes"
+	FamilyOther   EndpointFamily = "other"
+)
+
+// Rate limit headers sent by the API.
+const (
+	headerRateLimitRemaining = "X-Ratelimit-Remaining"
+	headerRateLimitReset     = "X-Ratelimit-Reset"
+)
+
+// RateLimit configures client-side throttling for one endpoint family.
+// Zero values disable the corresponding limit.
+type RateLimit struct {
+	// RequestsPerSecond is the sustained rate of the token bucket.
+	RequestsPerSecond float64
+	// Burst is the bucket size. If zero, it defaults to max(1, RequestsPerSecond).
+	Burst int
+	// MaxInFlight caps the number of concurrent requests for the family.
+	MaxInFlight int
+}
+
+// EndpointFamilyOf returns the endpoint family for an API request path.
+func EndpointFamilyOf(path string) EndpointFamily {
+	for _, segment := range strings.Split(path, "/") {
+		switch segment {
+		case "search":
+			return FamilySearch
+		case "analyze", "gist", "summarize":
+			return FamilyAnalyze
+		case "tasks":
+			return FamilyTasks
+		case "embed":
+			return FamilyEmbed
+		case "indexes":
+			return FamilyIndexes
+		}
+	}
+	return FamilyOther
+}
+
+// limiter is a token bucket combined with a semaphore for concurrent requests.
+// It also pauses when the server reports that the quota is exhausted.
+type limiter struct {
+	mu           sync.Mutex
+	rate         float64
+	burst        float64
+	tokens       float64
+	last         time.Time
+	blockedUntil time.Time
+	slots        chan struct{}
+}
+
+func newLimiter(limit RateLimit) *limiter {
+	l := &limiter{rate: limit.RequestsPerSecond, last: time.Now()}
+	if l.rate > 0 {
+		l.burst = float64(limit.Burst)
+		if l.burst <= 0 {
+			l.burst = max(1, l.rate)
+		}
+		l.tokens = l.burst
+	}
+	if limit.MaxInFlight > 0 {
+		l.slots = make(chan struct{}, limit.MaxInFlight)
+	}
+	return l
+}
+
+// acquire blocks until a request may be sent or ctx is done.
+// The returned function releases the in-flight slot and must be called exactly once.
+func (l *limiter) acquire(ctx context.Context) (func(), error) {
+	if l.slots != nil {
+		select {
+		case l.slots <- struct{}{}:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+	release := func() {
+		if l.slots != nil {
+			<-l.slots
+		}
+	}
+
+	for {
+		wait := l.reserve(time.Now())
+		if wait <= 0 {
+			return release, nil
+		}
+		if err := sleepContext(ctx, wait); err != nil {
+			release()
+			return nil, err
+		}
+	}
+}
+
+// reserve takes a token if one is available and otherwise returns how long to wait.
+func (l *limiter) reserve(now time.Time) time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if now.Before(l.blockedUntil) {
+		return l.blockedUntil.Sub(now)
+	}
+	if l.rate <= 0 {
+		return 0
+	}
+
+	l.tokens = min(l.burst, l.tokens+now.Sub(l.last).Seconds()*l.rate)
+	l.last = now
+	if l.tokens >= 1 {
+		l.tokens--
+		return 0
+	}
+	return time.Duration((1 - l.tokens) / l.rate * float64(time.Second))
+}
+
+// observe adapts the limiter to the quota reported by the server.
+func (l *limiter) observe(res *http.Response, now time.Time) {
+	var until time.Time
+	if res.StatusCode == http.StatusTooManyRequests {
+		if d, ok := parseRetryAfter(res.Header.Get("Retry-After"), now); ok {
+			until = now.Add(d)
+		}
+	}
+
+	remaining, err := strconv.Atoi(res.Header.Get(headerRateLimitRemaining))
+	hasRemaining := err == nil
+	if hasRemaining && remaining <= 0 {
+		if reset, ok := parseRateLimitReset(res.Header.Get(headerRateLimitReset), now); ok && reset.After(until) {
+			until = reset
+		}
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if until.After(l.blockedUntil) {
+		l.blockedUntil = until
+	}
+	if hasRemaining && l.rate > 0 && float64(remaining) < l.tokens {
+		l.tokens = float64(remaining)
+	}
+}
+
+// parseRateLimitReset accepts either a Unix timestamp or a number of seconds from now.
+func parseRateLimitReset(value string, now time.Time) (time.Time, bool) {
+	if value == "" {
+		return time.Time{}, false
+	}
+	n, err := strconv.ParseFloat(value, 64)
+	if err != nil || n < 0 {
+		return time.Time{}, false
+	}
+	// Values this large can only be Unix timestamps.
+	if n > 1e9 {
+		return time.Unix(int64(n), 0), true
+	}
+	return now.Add(time.Duration(n * float64(time.Second))), true
+}
+
+// releaseOnClose releases a limiter slot when the response body is closed,
+// so streamed responses returned by DoRaw hold their slot until consumed.
+type releaseOnClose struct {
+	io.ReadCloser
+	once    sync.Once
+	release func()
+}
+
+func (r *releaseOnClose) Close() error {
+	err := r.ReadCloser.Close()
+	r.once.Do(r.release)
+	return err
+}
@@ -0,0 +1,151 @@
+package client
+
+import (
+	"context"
+	stderrors "errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// newRateLimitedClient returns a client for server with limit applied to the tasks family.
+func newRateLimitedClient(server *httptest.Server, limit RateLimit) *Client {
+	return NewClient(&Options{
+		BaseURL:    server.URL,
+		APIKey:     "test-key",
+		RateLimits: map[EndpointFamily]RateLimit{FamilyTasks: limit},
+	})
+}
+
+func getTasks(t *testing.T, ctx context.Context, c *Client) *http.Request {
+	t.Helper()
+	req, err := c.NewRequest(ctx, http.MethodGet, "/tasks", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return req
+}
+
+func TestRateLimitMaxInFlight(t *testing.T) {
+	var inFlight, peak atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		current := inFlight.Add(1)
+		defer inFlight.Add(-1)
+		for {
+			highest := peak.Load()
+			if current <= highest || peak.CompareAndSwap(highest, current) {
+				break
+			}
+		}
+		time.Sleep(20 * time.Millisecond)
+	}))
+	defer server.Close()
+
+	c := newRateLimitedClient(server, RateLimit{MaxInFlight: 2})
+	var wg sync.WaitGroup
+	for range 8 {
+		req := getTasks(t, context.Background(), c)
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := c.Do(req, nil); err != nil {
+				t.Errorf("Do: %v", err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if got := peak.Load(); got != 2 {
+		t.Errorf("peak concurrent requests = %d, want 2", got)
+	}
+}
+
+func TestRateLimitAcquireReturnsContextError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer server.Close()
+
+	c := newRateLimitedClient(server, RateLimit{MaxInFlight: 1})
+	res, err := c.DoRaw(getTasks(t, context.Background(), c))
+	if err != nil {
+		t.Fatalf("DoRaw: %v", err)
+	}
+	defer res.Body.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	if _, err := c.Do(getTasks(t, ctx, c), nil); !stderrors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Do while the only slot is held = %v, want %v", err, context.DeadlineExceeded)
+	}
+}
+
+func TestRateLimitPausesUntilReset(t *testing.T) {
+	const reset = 200 * time.Millisecond
+	var mu sync.Mutex
+	var arrivals []time.Time
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		arrivals = append(arrivals, time.Now())
+		first := len(arrivals) == 1
+		mu.Unlock()
+		if first {
+			w.Header().Set(headerRateLimitRemaining, "0")
+			w.Header().Set(headerRateLimitReset, "0.2")
+		}
+	}))
+	defer server.Close()
+
+	c := newRateLimitedClient(server, RateLimit{})
+	for range 2 {
+		if _, err := c.Do(getTasks(t, context.Background(), c), nil); err != nil {
+			t.Fatalf("Do: %v", err)
+		}
+	}
+
+	// Allow for the time the first response took to reach the client.
+	if gap := arrivals[1].Sub(arrivals[0]); gap < reset-20*time.Millisecond {
+		t.Errorf("second request sent %v after the first, want at least %v", gap, reset)
+	}
+}
+
+func TestRateLimitHoldsSlotUntilBodyClosed(t *testing.T) {
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+	}))
+	defer server.Close()
+
+	c := newRateLimitedClient(server, RateLimit{MaxInFlight: 1})
+	res, err := c.DoRaw(getTasks(t, context.Background(), c))
+	if err != nil {
+		t.Fatalf("DoRaw: %v", err)
+	}
+
+	blocked := getTasks(t, context.Background(), c)
+	done := make(chan error, 1)
+	go func() {
+		_, err := c.Do(blocked, nil)
+		done <- err
+	}()
+
+	select {
+	case err := <-done:
+		t.Fatalf("second request finished while the first body was open: %v", err)
+	case <-time.After(50 * time.Millisecond):
+	}
+	if got := requests.Load(); got != 1 {
+		t.Fatalf("server received %d requests while the slot was held, want 1", got)
+	}
+
+	res.Body.Close()
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Errorf("second request: %v", err)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("second request still blocked after the first body was closed")
+	}
+}
@@ -0,0 +1,274 @@
+package client
+
+import (
+	"context"
+	stderrors "errors"
+	"io"
+	"log/slog"
+	"math"
+	"math/rand/v2"
+	"net"
+	"net/http"
+	"slices"
+	"strconv"
+	"syscall"
+	"time"
+)
+
+// RetryPolicy controls how the client retries requests that fail with a
+// transient network error or a retryable HTTP status code.
+//
+// Methods outside RetryMethods, such as the POST that creates a task, may have
+// been acted on by the server before it failed, so they are retried only when
+// the server certainly did not process them: on a 429 response or a refused
+// connection.
+//
+// Request bodies are replayed through http.Request.GetBody, which is set for the
+// JSON bodies and the streamed multipart uploads built by the SDK.
+// Requests whose body cannot be replayed are never retried.
+type RetryPolicy struct {
+	// MaxAttempts is the total number of attempts, including the first one.
+	// Values below 2 disable retries.
+	MaxAttempts int
+	// BaseBackoff is the delay before the first retry. It doubles on every attempt.
+	BaseBackoff time.Duration
+	// MaxBackoff caps every delay, jitter included.
+	MaxBackoff time.Duration
+	// Jitter is the fraction (0-1) of each delay that is randomised to avoid
+	// synchronised retries from many clients.
+	Jitter float64
+	// RetryableStatusCodes lists the HTTP status codes that trigger a retry.
+	RetryableStatusCodes []int
+	// RetryMethods lists the HTTP methods retried on any retryable status code or
+	// network error. If nil, the idempotent methods GET, HEAD, OPTIONS, PUT and
+	// DELETE are used.
+	RetryMethods []string
+	// RetryableError reports whether a transport error should be retried.
+	// If nil, IsRetryableNetworkError is used.
+	RetryableError func(error) bool
+	// IgnoreRetryAfter disables honoring the Retry-After response header.
+	IgnoreRetryAfter bool
+	// MaxRetryAfter is the longest Retry-After delay the client will wait.
+	// When the server asks for a longer delay the response is returned as-is.
+	// Zero means no limit.
+	MaxRetryAfter time.Duration
+}
+
+// DefaultRetryPolicy returns a policy suitable for most workloads: four attempts,
+// exponential backoff from 500ms up to 30s with 20% jitter, retrying 429 and 5xx
+// gateway errors as well as transient network failures. Only idempotent methods
+// are retried on 5xx errors and timeouts.
+func DefaultRetryPolicy() *RetryPolicy {
+	return &RetryPolicy{
+		MaxAttempts: 4,
+		BaseBackoff: 500 * time.Millisecond,
+		MaxBackoff:  30 * time.Second,
+		Jitter:      0.2,
+		RetryableStatusCodes: []int{
+			http.StatusTooManyRequests,
+			http.StatusInternalServerError,
+			http.StatusBadGateway,
+			http.StatusServiceUnavailable,
+			http.StatusGatewayTimeout,
+		},
+		MaxRetryAfter: time.Minute,
+	}
+}
+
+// idempotentMethods are the methods retried when RetryPolicy.RetryMethods is nil.
+var idempotentMethods = []string{
+	http.MethodGet,
+	http.MethodHead,
+	http.MethodOptions,
+	http.MethodPut,
+	http.MethodDelete,
+}
+
+// IsRetryableNetworkError reports whether err looks like a transient transport
+// failure such as a timeout, a reset or refused connection or an unexpected EOF.
+// Other network errors, e.g. DNS or TLS failures, are not retried, and neither
+// are context cancellation and deadline errors.
+func IsRetryableNetworkError(err error) bool {
+	if err == nil {
+		return false
+	}
+	if stderrors.Is(err, context.Canceled) || stderrors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+	if stderrors.Is(err, io.ErrUnexpectedEOF) || stderrors.Is(err, io.EOF) {
+		return true
+	}
+	if stderrors.Is(err, syscall.ECONNRESET) || stderrors.Is(err, syscall.ECONNREFUSED) ||
+		stderrors.Is(err, syscall.ECONNABORTED) || stderrors.Is(err, syscall.EPIPE) {
+		return true
+	}
+	var netErr net.Error
+	return stderrors.As(err, &netErr) && netErr.Timeout()
+}
+
+// do sends req through send, retrying according to the policy and logging each retry
+// with the URL formatted by logURL, which must keep credentials out of the logs.
+// The returned response is the outcome of the last attempt.
+func (p *RetryPolicy) do(req *http.Request, send func(*http.Request) (*http.Response, error), logger *slog.Logger, logURL func(*http.Request) string) (*http.Response, error) {
+	attempts := p.maxAttempts()
+	for attempt := 1; ; attempt++ {
+		res, err := send(req)
+		if attempt >= attempts || !p.shouldRetry(req, res, err) {
+			return res, err
+		}
+
+		wait, ok := p.delay(attempt, res)
+		if !ok {
+			return res, err
+		}
+		next, ok := rewindBody(req)
+		if !ok {
+			return res, err
+		}
+		attrs := []slog.Attr{
+			slog.String("method", req.Method),
+			slog.String("url", logURL(req)),
+			slog.Int("attempt", attempt),
+			slog.Duration("backoff", wait),
+		}
+		if res != nil {
+			attrs = append(attrs, slog.Int("status", res.StatusCode))
+			drainBody(res.Body)
+		} else {
+			attrs = append(attrs, slog.Any("error", err))
+		}
+		logger.LogAttrs(req.Context(), slog.LevelInfo, "twelvelabs: retrying request", attrs...)
+		if err := sleepContext(req.Context(), wait); err != nil {
+			return nil, err
+		}
+		req = next
+	}
+}
+
+func (p *RetryPolicy) maxAttempts() int {
+	if p == nil || p.MaxAttempts < 1 {
+		return 1
+	}
+	return p.MaxAttempts
+}
+
+// shouldRetry reports whether the outcome of an attempt warrants another one.
+func (p *RetryPolicy) shouldRetry(req *http.Request, res *http.Response, err error) bool {
+	if err != nil {
+		retryable := IsRetryableNetworkError(err)
+		if p.RetryableError != nil {
+			retryable = p.RetryableError(err)
+		}
+		return retryable && (p.retriesMethod(req.Method) || stderrors.Is(err, syscall.ECONNREFUSED))
+	}
+	if !slices.Contains(p.RetryableStatusCodes, res.StatusCode) {
+		return false
+	}
+	return p.retriesMethod(req.Method) || res.StatusCode == http.StatusTooManyRequests
+}
+
+// retriesMethod reports whether method is retried on every retryable outcome.
+func (p *RetryPolicy) retriesMethod(method string) bool {
+	if method == "" {
+		method = http.MethodGet
+	}
+	methods := p.RetryMethods
+	if methods == nil {
+		methods = idempotentMethods
+	}
+	return slices.Contains(methods, method)
+}
+
+// delay computes how long to wait before the given retry (1-based).
+// The second return value is false when the server requested a delay longer
+// than MaxRetryAfter, in which case the caller should stop retrying.
+func (p *RetryPolicy) delay(retry int, res *http.Response) (time.Duration, bool) {
+	backoff := p.backoff(retry)
+	if res == nil || p.IgnoreRetryAfter {
+		return backoff, true
+	}
+	retryAfter, ok := parseRetryAfter(res.Header.Get("Retry-After"), time.Now())
+	if !ok {
+		return backoff, true
+	}
+	if p.MaxRetryAfter > 0 && retryAfter > p.MaxRetryAfter {
+		return 0, false
+	}
+	return max(backoff, retryAfter), true
+}
+
+func (p *RetryPolicy) backoff(retry int) time.Duration {
+	base := p.BaseBackoff
+	if base <= 0 {
+		base = 500 * time.Millisecond
+	}
+	d := float64(base) * math.Pow(2, float64(retry-1))
+	if jitter := min(max(p.Jitter, 0), 1); jitter > 0 {
+		spread := d * jitter
+		d = d - spread + rand.Float64()*2*spread
+	}
+	// The cap applies after the jitter so that no delay exceeds MaxBackoff.
+	if p.MaxBackoff > 0 && d > float64(p.MaxBackoff) {
+		return p.MaxBackoff
+	}
+	if d >= math.MaxInt64 {
+		return math.MaxInt64
+	}
+	return time.Duration(d)
+}
+
+// parseRetryAfter parses a Retry-After header given either in seconds or as an HTTP date.
+func parseRetryAfter(value string, now time.Time) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+	if at, err := http.ParseTime(value); err == nil {
+		return max(at.Sub(now), 0), true
+	}
+	return 0, false
+}
+
+// rewindBody prepares req for another attempt by obtaining a fresh copy of its body.
+// It reports false when the body cannot be replayed.
+func rewindBody(req *http.Request) (*http.Request, bool) {
+	if req.Body == nil || req.Body == http.NoBody {
+		return req, true
+	}
+	if req.GetBody == nil {
+		return nil, false
+	}
+	body, err := req.GetBody()
+	if err != nil {
+		return nil, false
+	}
+	next := req.Clone(req.Context())
+	next.Body = body
+	return next, true
+}
+
+// drainBody discards and closes a response body so the connection can be reused.
+func drainBody(body io.ReadCloser) {
+	_, _ = io.Copy(io.Discard, io.LimitReader(body, 64<<10))
+	_ = body.Close()
+}
+
+// sleepContext waits for d or until ctx is done, whichever comes first.
+func sleepContext(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,115 @@
+package client
+
+import (
+	"context"
+	stderrors "errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"sync/atomic"
+	"syscall"
+	"testing"
+	"time"
+)
+
+func TestRetryMethods(t *testing.T) {
+	tests := []struct {
+		name         string
+		method       string
+		status       int
+		retryMethods []string
+		wantAttempts int32
+	}{
+		{name: "GET is retried on 503", method: http.MethodGet, status: http.StatusServiceUnavailable, wantAttempts: 3},
+		{name: "DELETE is retried on 502", method: http.MethodDelete, status: http.StatusBadGateway, wantAttempts: 3},
+		{name: "POST is not retried on 503", method: http.MethodPost, status: http.StatusServiceUnavailable, wantAttempts: 1},
+		{name: "POST is retried on 429", method: http.MethodPost, status: http.StatusTooManyRequests, wantAttempts: 3},
+		{
+			name:         "POST listed in RetryMethods is retried on 503",
+			method:       http.MethodPost,
+			status:       http.StatusServiceUnavailable,
+			retryMethods: []string{http.MethodGet, http.MethodPost},
+			wantAttempts: 3,
+		},
+		{
+			name:         "GET left out of RetryMethods is not retried on 500",
+			method:       http.MethodGet,
+			status:       http.StatusInternalServerError,
+			retryMethods: []string{http.MethodPut},
+			wantAttempts: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var attempts atomic.Int32
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				attempts.Add(1)
+				w.WriteHeader(tt.status)
+			}))
+			defer server.Close()
+
+			policy := DefaultRetryPolicy()
+			policy.MaxAttempts = 3
+			policy.BaseBackoff = time.Millisecond
+			policy.RetryMethods = tt.retryMethods
+			c := NewClient(&Options{BaseURL: server.URL, APIKey: "test-key", Retry: policy})
+
+			req, err := c.NewRequest(context.Background(), tt.method, "/tasks", map[string]string{"index_id": "index-1"})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if _, err := c.Do(req, nil); err == nil {
+				t.Fatalf("Do succeeded on HTTP %d", tt.status)
+			}
+			if got := attempts.Load(); got != tt.wantAttempts {
+				t.Errorf("attempts = %d, want %d", got, tt.wantAttempts)
+			}
+		})
+	}
+}
+
+func TestBackoffCapIncludesJitter(t *testing.T) {
+	policy := &RetryPolicy{BaseBackoff: time.Second, MaxBackoff: 3 * time.Second, Jitter: 1}
+	for retry := 1; retry <= 8; retry++ {
+		for range 100 {
+			if d := policy.backoff(retry); d < 0 || d > policy.MaxBackoff {
+				t.Fatalf("backoff(%d) = %v, want between 0 and %v", retry, d, policy.MaxBackoff)
+			}
+		}
+	}
+
+	policy = &RetryPolicy{BaseBackoff: time.Second, MaxBackoff: time.Minute}
+	for retry, want := range map[int]time.Duration{1: time.Second, 3: 4 * time.Second, 100: time.Minute} {
+		if d := policy.backoff(retry); d != want {
+			t.Errorf("backoff(%d) without jitter = %v, want %v", retry, d, want)
+		}
+	}
+}
+
+func TestIsRetryableNetworkError(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{name: "nil", err: nil, want: false},
+		{name: "unexpected EOF", err: fmt.Errorf("read body: %w", io.ErrUnexpectedEOF), want: true},
+		{name: "connection reset", err: &net.OpError{Op: "read", Net: "tcp", Err: os.NewSyscallError("read", syscall.ECONNRESET)}, want: true},
+		{name: "connection refused", err: &net.OpError{Op: "dial", Net: "tcp", Err: os.NewSyscallError("connect", syscall.ECONNREFUSED)}, want: true},
+		{name: "timeout", err: &net.OpError{Op: "read", Net: "tcp", Err: os.ErrDeadlineExceeded}, want: true},
+		{name: "DNS failure", err: &net.OpError{Op: "dial", Net: "tcp", Err: &net.DNSError{Err: "no such host", Name: "api.example", IsNotFound: true}}, want: false},
+		{name: "TLS failure", err: &net.OpError{Op: "remote error", Err: stderrors.New("tls: bad certificate")}, want: false},
+		{name: "context canceled", err: fmt.Errorf("request: %w", context.Canceled), want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsRetryableNetworkError(tt.err); got != tt.want {
+				t.Errorf("IsRetryableNetworkError(%v) = %v, want %v", tt.err, got, tt.want)
+			}
+		})
+	}
+}
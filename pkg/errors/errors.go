@@ -1,15 +1,100 @@
 package errors
 
-import "fmt"
+import (
+	stderrors "errors"
+	"fmt"
+	"net/http"
+)
+
+// Sentinel errors for use with errors.Is. Every typed error below matches the
+// sentinel for its status code, also when wrapped in a ServiceError.
+var (
+	ErrBadRequest          = stderrors.New("bad request")
+	ErrUnauthorized        = stderrors.New("unauthorized")
+	ErrForbidden           = stderrors.New("forbidden")
+	ErrNotFound            = stderrors.New("not found")
+	ErrConflict            = stderrors.New("conflict")
+	ErrPayloadTooLarge     = stderrors.New("payload too large")
+	ErrUnprocessableEntity = stderrors.New("unprocessable entity")
+	ErrTooManyRequests     = stderrors.New("too many requests")
+	ErrInternalServer      = stderrors.New("internal server error")
+	ErrBadGateway          = stderrors.New("bad gateway")
+	ErrServiceUnavailable  = stderrors.New("service unavailable")
+	ErrGatewayTimeout      = stderrors.New("gateway timeout")
+	ErrValidation          = stderrors.New("validation error")
+	ErrRequest             = stderrors.New("request error")
+	ErrTimeout             = stderrors.New("timeout")
+)
+
+// statusSentinels maps HTTP status codes to their sentinel errors.
+var statusSentinels = map[int]error{
+	http.StatusBadRequest:            ErrBadRequest,
+	http.StatusUnauthorized:          ErrUnauthorized,
+	http.StatusForbidden:             ErrForbidden,
+	http.StatusNotFound:              ErrNotFound,
+	http.StatusConflict:              ErrConflict,
+	http.StatusRequestEntityTooLarge: ErrPayloadTooLarge,
+	http.StatusUnprocessableEntity:   ErrUnprocessableEntity,
+	http.StatusTooManyRequests:       ErrTooManyRequests,
+	http.StatusInternalServerError:   ErrInternalServer,
+	http.StatusBadGateway:            ErrBadGateway,
+	http.StatusServiceUnavailable:    ErrServiceUnavailable,
+	http.StatusGatewayTimeout:        ErrGatewayTimeout,
+}
 
 // APIError represents a generic API error
 type APIError struct {
 	StatusCode int    `json:"status_code"`
 	Message    string `json:"message"`
+	// Code is the machine-readable error code returned by the API, e.g. "index_not_found".
+	Code string `json:"code,omitempty"`
+	// DocsURL links to the documentation for Code.
+	DocsURL string `json:"docs_url,omitempty"`
+	// RequestID identifies the request in TwelveLabs support tickets.
+	RequestID string `json:"request_id,omitempty"`
+	// RawBody is the unparsed response body.
+	RawBody []byte `json:"-"`
 }
 
 func (e *APIError) Error() string {
-	return fmt.Sprintf("API Error %d: %s", e.StatusCode, e.Message)
+	return fmt.Sprintf("API Error %d: %s%s", e.StatusCode, e.Message, e.details())
+}
+
+// details formats the optional error code and request ID for Error messages.
+func (e *APIError) details() string {
+	switch {
+	case e.Code != "" && e.RequestID != "":
+		return fmt.Sprintf(" (code: %s, request_id: %s)", e.Code, e.RequestID)
+	case e.Code != "":
+		return fmt.Sprintf(" (code: %s)", e.Code)
+	case e.RequestID != "":
+		return fmt.Sprintf(" (request_id: %s)", e.RequestID)
+	default:
+		return ""
+	}
+}
+
+// Is reports whether target is the sentinel error for the status code of e.
+func (e *APIError) Is(target error) bool {
+	sentinel, ok := statusSentinels[e.StatusCode]
+	return ok && target == sentinel
+}
+
+// IsRetryable reports whether the request may succeed if sent again:
+// rate limiting, request timeouts and transient server errors.
+func (e *APIError) IsRetryable() bool {
+	switch e.StatusCode {
+	case http.StatusRequestTimeout, http.StatusTooManyRequests, http.StatusInternalServerError,
+		http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	default:
+		return false
+	}
+}
+
+// IsRateLimit reports whether the request was rejected by the API rate limiter.
+func (e *APIError) IsRateLimit() bool {
+	return e.StatusCode == http.StatusTooManyRequests
 }
 
 // BadRequestError represents a 400 Bad Request error
@@ -18,7 +103,7 @@ type BadRequestError struct {
 }
 
 func (e *BadRequestError) Error() string {
-	return fmt.Sprintf("Bad Request (400): %s", e.Message)
+	return fmt.Sprintf("Bad Request (400): %s%s", e.Message, e.details())
 }
 
 // UnauthorizedError represents a 401 Unauthorized error
@@ -27,7 +112,16 @@ type UnauthorizedError struct {
 }
 
 func (e *UnauthorizedError) Error() string {
-	return fmt.Sprintf("Unauthorized (401): %s", e.Message)
+	return fmt.Sprintf("Unauthorized (401): %s%s", e.Message, e.details())
+}
+
+// ForbiddenError represents a 403 Forbidden error
+type ForbiddenError struct {
+	APIError
+}
+
+func (e *ForbiddenError) Error() string {
+	return fmt.Sprintf("Forbidden (403): %s%s", e.Message, e.details())
 }
 
 // NotFoundError represents a 404 Not Found error
@@ -36,7 +130,34 @@ type NotFoundError struct {
 }
 
 func (e *NotFoundError) Error() string {
-	return fmt.Sprintf("Not Found (404): %s", e.Message)
+	return fmt.Sprintf("Not Found (404): %s%s", e.Message, e.details())
+}
+
+// ConflictError represents a 409 Conflict error
+type ConflictError struct {
+	APIError
+}
+
+func (e *ConflictError) Error() string {
+	return fmt.Sprintf("Conflict (409): %s%s", e.Message, e.details())
+}
+
+// PayloadTooLargeError represents a 413 Payload Too Large error
+type PayloadTooLargeError struct {
+	APIError
+}
+
+func (e *PayloadTooLargeError) Error() string {
+	return fmt.Sprintf("Payload Too Large (413): %s%s", e.Message, e.details())
+}
+
+// UnprocessableEntityError represents a 422 Unprocessable Entity error
+type UnprocessableEntityError struct {
+	APIError
+}
+
+func (e *UnprocessableEntityError) Error() string {
+	return fmt.Sprintf("Unprocessable Entity (422): %s%s", e.Message, e.details())
 }
 
 // TooManyRequestsError represents a 429 Too Many Requests error
@@ -45,7 +166,7 @@ type TooManyRequestsError struct {
 }
 
 func (e *TooManyRequestsError) Error() string {
-	return fmt.Sprintf("Too Many Requests (429): %s", e.Message)
+	return fmt.Sprintf("Too Many Requests (429): %s%s", e.Message, e.details())
 }
 
 // InternalServerError represents a 500 Internal Server Error
@@ -54,7 +175,34 @@ type InternalServerError struct {
 }
 
 func (e *InternalServerError) Error() string {
-	return fmt.Sprintf("Internal Server Error (500): %s", e.Message)
+	return fmt.Sprintf("Internal Server Error (500): %s%s", e.Message, e.details())
+}
+
+// BadGatewayError represents a 502 Bad Gateway error
+type BadGatewayError struct {
+	APIError
+}
+
+func (e *BadGatewayError) Error() string {
+	return fmt.Sprintf("Bad Gateway (502): %s%s", e.Message, e.details())
+}
+
+// ServiceUnavailableError represents a 503 Service Unavailable error
+type ServiceUnavailableError struct {
+	APIError
+}
+
+func (e *ServiceUnavailableError) Error() string {
+	return fmt.Sprintf("Service Unavailable (503): %s%s", e.Message, e.details())
+}
+
+// GatewayTimeoutError represents a 504 Gateway Timeout error
+type GatewayTimeoutError struct {
+	APIError
+}
+
+func (e *GatewayTimeoutError) Error() string {
+	return fmt.Sprintf("Gateway Timeout (504): %s%s", e.Message, e.details())
 }
 
 // ValidationError represents a validation error for invalid parameters
@@ -66,16 +214,48 @@ func (e *ValidationError) Error() string {
 	return fmt.Sprintf("Validation Error: %s", e.Message)
 }
 
-// ServiceError represents a service-level error
+// Is reports whether target is ErrValidation or ErrBadRequest.
+func (e *ValidationError) Is(target error) bool {
+	return target == ErrValidation || target == ErrBadRequest
+}
+
+// IsRetryable always reports false: invalid parameters fail the same way every time.
+func (e *ValidationError) IsRetryable() bool {
+	return false
+}
+
+// ServiceError represents a service-level error.
+// It wraps the underlying error, so errors.Is and errors.As see through it.
 type ServiceError struct {
 	APIError
 	ServiceName string
+	Err         error
 }
 
 func (e *ServiceError) Error() string {
 	return fmt.Sprintf("%s Service Error: %s", e.ServiceName, e.Message)
 }
 
+// Unwrap returns the underlying error.
+func (e *ServiceError) Unwrap() error {
+	return e.Err
+}
+
+// Is does not match any sentinel itself; matching is delegated to the wrapped error.
+func (e *ServiceError) Is(target error) bool {
+	return false
+}
+
+// IsRetryable reports whether the wrapped error is retryable.
+func (e *ServiceError) IsRetryable() bool {
+	return IsRetryable(e.Err)
+}
+
+// IsRateLimit reports whether the wrapped error is a rate limit error.
+func (e *ServiceError) IsRateLimit() bool {
+	return IsRateLimit(e.Err)
+}
+
 // RequestError represents an error creating or processing a request
 type RequestError struct {
 	APIError
@@ -85,6 +265,16 @@ func (e *RequestError) Error() string {
 	return fmt.Sprintf("Request Error: %s", e.Message)
 }
 
+// Is reports whether target is ErrRequest.
+func (e *RequestError) Is(target error) bool {
+	return target == ErrRequest
+}
+
+// IsRetryable always reports false: the request could not be built.
+func (e *RequestError) IsRetryable() bool {
+	return false
+}
+
 // TimeoutError represents a timeout error
 type TimeoutError struct {
 	APIError
@@ -94,6 +284,56 @@ func (e *TimeoutError) Error() string {
 	return fmt.Sprintf("Timeout Error: %s", e.Message)
 }
 
+// Is reports whether target is ErrTimeout.
+func (e *TimeoutError) Is(target error) bool {
+	return target == ErrTimeout
+}
+
+// IsRetryable reports whether err, or any error it wraps, is a retryable API error.
+func IsRetryable(err error) bool {
+	var r interface{ IsRetryable() bool }
+	return stderrors.As(err, &r) && r.IsRetryable()
+}
+
+// IsRateLimit reports whether err, or any error it wraps, is a 429 rate limit error.
+func IsRateLimit(err error) bool {
+	var r interface{ IsRateLimit() bool }
+	return stderrors.As(err, &r) && r.IsRateLimit()
+}
+
+// FromAPIError returns the typed error matching the status code of apiErr,
+// or apiErr itself when there is no dedicated type.
+func FromAPIError(apiErr *APIError) error {
+	switch apiErr.StatusCode {
+	case http.StatusBadRequest:
+		return &BadRequestError{APIError: *apiErr}
+	case http.StatusUnauthorized:
+		return &UnauthorizedError{APIError: *apiErr}
+	case http.StatusForbidden:
+		return &ForbiddenError{APIError: *apiErr}
+	case http.StatusNotFound:
+		return &NotFoundError{APIError: *apiErr}
+	case http.StatusConflict:
+		return &ConflictError{APIError: *apiErr}
+	case http.StatusRequestEntityTooLarge:
+		return &PayloadTooLargeError{APIError: *apiErr}
+	case http.StatusUnprocessableEntity:
+		return &UnprocessableEntityError{APIError: *apiErr}
+	case http.StatusTooManyRequests:
+		return &TooManyRequestsError{APIError: *apiErr}
+	case http.StatusInternalServerError:
+		return &InternalServerError{APIError: *apiErr}
+	case http.StatusBadGateway:
+		return &BadGatewayError{APIError: *apiErr}
+	case http.StatusServiceUnavailable:
+		return &ServiceUnavailableError{APIError: *apiErr}
+	case http.StatusGatewayTimeout:
+		return &GatewayTimeoutError{APIError: *apiErr}
+	default:
+		return apiErr
+	}
+}
+
 // NewBadRequestError creates a new BadRequestError
 func NewBadRequestError(message string) *BadRequestError {
 	return &BadRequestError{
@@ -114,6 +354,16 @@ func NewUnauthorizedError(message string) *UnauthorizedError {
 	}
 }
 
+// NewForbiddenError creates a new ForbiddenError
+func NewForbiddenError(message string) *ForbiddenError {
+	return &ForbiddenError{
+		APIError: APIError{
+			StatusCode: 403,
+			Message:    message,
+		},
+	}
+}
+
 // NewNotFoundError creates a new NotFoundError
 func NewNotFoundError(message string) *NotFoundError {
 	return &NotFoundError{
@@ -124,6 +374,36 @@ func NewNotFoundError(message string) *NotFoundError {
 	}
 }
 
+// NewConflictError creates a new ConflictError
+func NewConflictError(message string) *ConflictError {
+	return &ConflictError{
+		APIError: APIError{
+			StatusCode: 409,
+			Message:    message,
+		},
+	}
+}
+
+// NewPayloadTooLargeError creates a new PayloadTooLargeError
+func NewPayloadTooLargeError(message string) *PayloadTooLargeError {
+	return &PayloadTooLargeError{
+		APIError: APIError{
+			StatusCode: 413,
+			Message:    message,
+		},
+	}
+}
+
+// NewUnprocessableEntityError creates a new UnprocessableEntityError
+func NewUnprocessableEntityError(message string) *UnprocessableEntityError {
+	return &UnprocessableEntityError{
+		APIError: APIError{
+			StatusCode: 422,
+			Message:    message,
+		},
+	}
+}
+
 // NewTooManyRequestsError creates a new TooManyRequestsError
 func NewTooManyRequestsError(message string) *TooManyRequestsError {
 	return &TooManyRequestsError{
@@ -144,6 +424,36 @@ func NewInternalServerError(message string) *InternalServerError {
 	}
 }
 
+// NewBadGatewayError creates a new BadGatewayError
+func NewBadGatewayError(message string) *BadGatewayError {
+	return &BadGatewayError{
+		APIError: APIError{
+			StatusCode: 502,
+			Message:    message,
+		},
+	}
+}
+
+// NewServiceUnavailableError creates a new ServiceUnavailableError
+func NewServiceUnavailableError(message string) *ServiceUnavailableError {
+	return &ServiceUnavailableError{
+		APIError: APIError{
+			StatusCode: 503,
+			Message:    message,
+		},
+	}
+}
+
+// NewGatewayTimeoutError creates a new GatewayTimeoutError
+func NewGatewayTimeoutError(message string) *GatewayTimeoutError {
+	return &GatewayTimeoutError{
+		APIError: APIError{
+			StatusCode: 504,
+			Message:    message,
+		},
+	}
+}
+
 // NewValidationError creates a new ValidationError
 func NewValidationError(message string) *ValidationError {
 	return &ValidationError{
@@ -165,6 +475,29 @@ func NewServiceError(serviceName, message string) *ServiceError {
 	}
 }
 
+// WrapServiceError creates a ServiceError that wraps err. The message becomes
+// "message: err", and the status code, error code and request ID are taken from
+// the wrapped API error when there is one.
+func WrapServiceError(serviceName, message string, err error) *ServiceError {
+	serviceErr := NewServiceError(serviceName, message+": "+err.Error())
+	serviceErr.Err = err
+
+	var apiErr interface{ apiError() *APIError }
+	if stderrors.As(err, &apiErr) {
+		cause := apiErr.apiError()
+		serviceErr.StatusCode = cause.StatusCode
+		serviceErr.Code = cause.Code
+		serviceErr.DocsURL = cause.DocsURL
+		serviceErr.RequestID = cause.RequestID
+	}
+	return serviceErr
+}
+
+// apiError gives WrapServiceError access to the embedded APIError of any typed error.
+func (e *APIError) apiError() *APIError {
+	return e
+}
+
 // NewRequestError creates a new RequestError
 func NewRequestError(message string) *RequestError {
 	return &RequestError{
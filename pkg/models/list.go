@@ -0,0 +1,234 @@
+package models
+
+import (
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// ListResponse is a single page of a listing endpoint.
+type ListResponse[T any] struct {
+	Data     []T       `json:"data"`
+	PageInfo *PageInfo `json:"page_info,omitempty"`
+}
+
+// TimeRange filters on a timestamp. Either bound may be left zero.
+type TimeRange struct {
+	// From matches timestamps at or after this time.
+	From time.Time
+	// To matches timestamps at or before this time.
+	To time.Time
+}
+
+// encode adds the bounds of r to values as key[gte] and key[lte], in RFC 3339 format.
+func (r *TimeRange) encode(values url.Values, key string) {
+	if r == nil {
+		return
+	}
+	if !r.From.IsZero() {
+		values.Set(key+"[gte]", r.From.Format(time.RFC3339))
+	}
+	if !r.To.IsZero() {
+		values.Set(key+"[lte]", r.To.Format(time.RFC3339))
+	}
+}
+
+// TaskListOptions filters, sorts and paginates the tasks listing. All fields are optional.
+type TaskListOptions struct {
+	// IndexID limits the listing to the tasks of an index.
+	IndexID string
+	// Status limits the listing to tasks in any of the given statuses.
+	Status []TaskStatus
+	// Filename limits the listing to tasks for videos with this file name.
+	Filename string
+	// CreatedAt limits the listing to tasks created within the range.
+	CreatedAt *TimeRange
+	// SortBy is the field to sort on: "created_at" (the API default) or "updated_at".
+	SortBy string
+	// SortOption is the sort order: "desc" (the API default) or "asc".
+	SortOption string
+	// Page is the page to return, starting at 1.
+	Page int
+	// PageLimit is the number of tasks per page, at most 50.
+	PageLimit int
+}
+
+// Values encodes the options as query parameters.
+func (o *TaskListOptions) Values() url.Values {
+	values := url.Values{}
+	if o == nil {
+		return values
+	}
+	if o.IndexID != "" {
+		values.Set("index_id", o.IndexID)
+	}
+	for _, status := range o.Status {
+		values.Add("status", string(status))
+	}
+	if o.Filename != "" {
+		values.Set("filename", o.Filename)
+	}
+	o.CreatedAt.encode(values, "created_at")
+	setPagination(values, o.SortBy, o.SortOption, o.Page, o.PageLimit)
+	return values
+}
+
+// setPagination adds the sort and page parameters shared by all listings.
+func setPagination(values url.Values, sortBy, sortOption string, page, pageLimit int) {
+	if sortBy != "" {
+		values.Set("sort_by", sortBy)
+	}
+	if sortOption != "" {
+		values.Set("sort_option", sortOption)
+	}
+	if page > 0 {
+		values.Set("page", strconv.Itoa(page))
+	}
+	if pageLimit > 0 {
+		values.Set("page_limit", strconv.Itoa(pageLimit))
+	}
+}
+
+// Range filters on a number. A zero bound is left open.
+type Range struct {
+	// Min matches values greater than or equal to Min.
+	Min float64
+	// Max matches values less than or equal to Max.
+	Max float64
+}
+
+// encode adds the bounds of r to values as key[gte] and key[lte].
+func (r *Range) encode(values url.Values, key string) {
+	if r == nil {
+		return
+	}
+	if r.Min != 0 {
+		values.Set(key+"[gte]", strconv.FormatFloat(r.Min, 'f', -1, 64))
+	}
+	if r.Max != 0 {
+		values.Set(key+"[lte]", strconv.FormatFloat(r.Max, 'f', -1, 64))
+	}
+}
+
+// IndexListOptions filters, sorts and paginates the indexes listing. All fields are optional.
+type IndexListOptions struct {
+	// IndexName limits the listing to indexes with this name.
+	IndexName string
+	// ModelOptions limits the listing to indexes using all of these model options, e.g. "visual".
+	ModelOptions []string
+	// ModelFamily limits the listing to indexes using a model family: "marengo" or "pegasus".
+	ModelFamily string
+	// CreatedAt limits the listing to indexes created within the range.
+	CreatedAt *TimeRange
+	// UpdatedAt limits the listing to indexes updated within the range.
+	UpdatedAt *TimeRange
+	// SortBy is the field to sort on: "created_at" (the API default) or "updated_at".
+	SortBy string
+	// SortOption is the sort order: "desc" (the API default) or "asc".
+	SortOption string
+	// Page is the page to return, starting at 1.
+	Page int
+	// PageLimit is the number of indexes per page, at most 50.
+	PageLimit int
+}
+
+// Values encodes the options as query parameters.
+func (o *IndexListOptions) Values() url.Values {
+	values := url.Values{}
+	if o == nil {
+		return values
+	}
+	if o.IndexName != "" {
+		values.Set("index_name", o.IndexName)
+	}
+	for _, option := range o.ModelOptions {
+		values.Add("model_options", option)
+	}
+	if o.ModelFamily != "" {
+		values.Set("model_family", o.ModelFamily)
+	}
+	o.CreatedAt.encode(values, "created_at")
+	o.UpdatedAt.encode(values, "updated_at")
+	setPagination(values, o.SortBy, o.SortOption, o.Page, o.PageLimit)
+	return values
+}
+
+// VideoListOptions filters, sorts and paginates the videos of an index. All fields are optional.
+type VideoListOptions struct {
+	// Filename limits the listing to videos with this file name.
+	Filename string
+	// Duration limits the listing to videos whose duration in seconds is within the range.
+	Duration *Range
+	// FPS limits the listing to videos whose frame rate is within the range.
+	FPS *Range
+	// Width limits the listing to videos whose width in pixels is within the range.
+	Width *Range
+	// Height limits the listing to videos whose height in pixels is within the range.
+	Height *Range
+	// Size limits the listing to videos whose file size in bytes is within the range.
+	Size *Range
+	// CreatedAt limits the listing to videos indexed within the range.
+	CreatedAt *TimeRange
+	// UpdatedAt limits the listing to videos updated within the range.
+	UpdatedAt *TimeRange
+	// UserMetadata limits the listing to videos whose user metadata has all of these values.
+	UserMetadata map[string]string
+	// Filter adds structured conditions, such as a search.Filter, to the other filters.
+	Filter QueryFilter
+	// SortBy is the field to sort on: "created_at" (the API default) or "updated_at".
+	SortBy string
+	// SortOption is the sort order: "desc" (the API default) or "asc".
+	SortOption string
+	// Page is the page to return, starting at 1.
+	Page int
+	// PageLimit is the number of videos per page, at most 50.
+	PageLimit int
+}
+
+// Values encodes the options as query parameters. User metadata filters are
+// encoded as user_metadata[key]. It returns an error if Filter cannot be encoded.
+func (o *VideoListOptions) Values() (url.Values, error) {
+	values := url.Values{}
+	if o == nil {
+		return values, nil
+	}
+	if o.Filename != "" {
+		values.Set("filename", o.Filename)
+	}
+	o.Duration.encode(values, "duration")
+	o.FPS.encode(values, "fps")
+	o.Width.encode(values, "width")
+	o.Height.encode(values, "height")
+	o.Size.encode(values, "size")
+	o.CreatedAt.encode(values, "created_at")
+	o.UpdatedAt.encode(values, "updated_at")
+	for key, value := range o.UserMetadata {
+		values.Set("user_metadata["+key+"]", value)
+	}
+	if o.Filter != nil {
+		filter, err := o.Filter.QueryValues()
+		if err != nil {
+			return nil, err
+		}
+		for key, list := range filter {
+			values[key] = append(values[key], list...)
+		}
+	}
+	setPagination(values, o.SortBy, o.SortOption, o.Page, o.PageLimit)
+	return values, nil
+}
+
+// Validate reports whether Filter can be encoded as query parameters.
+func (o *VideoListOptions) Validate() error {
+	if o == nil || o.Filter == nil {
+		return nil
+	}
+	_, err := o.Filter.QueryValues()
+	return err
+}
+
+// QueryFilter is a structured filter that can be encoded as query parameters of
+// a listing. search.Filter implements it.
+type QueryFilter interface {
+	QueryValues() (url.Values, error)
+}
@@ -0,0 +1,43 @@
+package models
+
+import "io"
+
+// MediaSource describes media to upload: a file on disk, a reader or an in-memory
+// byte slice. Create one with MediaFromPath, MediaFromReader or MediaFromBytes.
+//
+// Path and byte slice sources can be sent again when a request is retried. Reader
+// sources can only be retried if the reader also implements io.Seeker; otherwise
+// the upload is attempted once.
+type MediaSource struct {
+	// Path is a local file path. Filename and Size are taken from the file when empty.
+	Path string
+	// Reader streams the media content.
+	Reader io.Reader
+	// Data holds the media content in memory.
+	Data []byte
+	// Filename is the file name sent with the upload. Its extension is used to
+	// detect the content type.
+	Filename string
+	// Size is the length of the content in bytes. Zero or less means unknown: the
+	// size of a seekable reader is then found by seeking, and a non-seekable reader
+	// is rejected with a validation error.
+	Size int64
+	// ContentType overrides the detected MIME type (optional).
+	ContentType string
+}
+
+// MediaFromPath returns a media source that reads the file at path.
+func MediaFromPath(path string) *MediaSource {
+	return &MediaSource{Path: path}
+}
+
+// MediaFromReader returns a media source that streams size bytes from r.
+// Pass a size of zero or less to determine it by seeking when r implements io.Seeker.
+func MediaFromReader(r io.Reader, filename string, size int64) *MediaSource {
+	return &MediaSource{Reader: r, Filename: filename, Size: size}
+}
+
+// MediaFromBytes returns a media source for content held in memory.
+func MediaFromBytes(data []byte, filename string) *MediaSource {
+	return &MediaSource{Data: data, Filename: filename, Size: int64(len(data))}
+}
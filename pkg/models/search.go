@@ -0,0 +1,249 @@
+package models
+
+import (
+	"cmp"
+	"slices"
+)
+
+// VideoSearchResult gathers the clips of a single video matching a search.
+type VideoSearchResult struct {
+	// VideoID identifies the video.
+	VideoID string
+	// MaxScore is the highest score of the clips.
+	MaxScore float64
+	// MeanScore is the average score of the clips.
+	MeanScore float64
+	// MatchedDuration is the time in seconds covered by the clips, counting the
+	// overlap of overlapping clips once.
+	MatchedDuration float64
+	// Clips are the matching clips of the video in time order.
+	Clips []SearchResult
+}
+
+// IsGrouped reports whether the results are grouped by video, as returned for a
+// search with GroupBy set to SearchGroupByVideo.
+func (r *SearchResponse) IsGrouped() bool {
+	for _, result := range r.Data {
+		if result.ID != "" || len(result.Clips) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// Results returns the matching clips as a flat list, whether or not the search
+// was grouped by video. Clips of grouped results have their VideoID set from the
+// video they belong to. A grouped video without clips has no clip to list; use
+// GroupByVideo to see it.
+func (r *SearchResponse) Results() []SearchResult {
+	return flattenSearchResults(r.Data)
+}
+
+// GroupByVideo gathers the matching clips by video, whether or not the search
+// was grouped by video. Videos are returned in the order the API ranked them,
+// that is in the order their first clip appears. A grouped video without clips
+// is kept, with no clips and zero scores and duration.
+//
+// Example:
+//
+//	for _, video := range response.GroupByVideo() {
+//	    fmt.Printf("%s: %d clips, best %.1f, %.0fs matched\n",
+//	        video.VideoID, len(video.Clips), video.MaxScore, video.MatchedDuration)
+//	}
+func (r *SearchResponse) GroupByVideo() []VideoSearchResult {
+	return GroupSearchResults(r.Data)
+}
+
+// GroupSearchResults gathers search results by video, as SearchResponse.GroupByVideo
+// does. It accepts results of either shape, e.g. those collected from several pages.
+func GroupSearchResults(results []SearchResult) []VideoSearchResult {
+	var order []string
+	clips := make(map[string][]SearchResult)
+	add := func(videoID string, videoClips ...SearchResult) {
+		if _, ok := clips[videoID]; !ok {
+			order = append(order, videoID)
+		}
+		clips[videoID] = append(clips[videoID], videoClips...)
+	}
+	for _, result := range results {
+		if result.ID != "" && len(result.Clips) == 0 {
+			add(result.ID)
+			continue
+		}
+		for _, clip := range flattenSearchResults([]SearchResult{result}) {
+			add(clip.VideoID, clip)
+		}
+	}
+
+	videos := make([]VideoSearchResult, len(order))
+	for i, videoID := range order {
+		videos[i] = newVideoSearchResult(videoID, clips[videoID])
+	}
+	return videos
+}
+
+// flattenSearchResults replaces grouped results by their clips. A grouped result
+// without clips has none to contribute and is left out.
+func flattenSearchResults(results []SearchResult) []SearchResult {
+	flat := make([]SearchResult, 0, len(results))
+	for _, result := range results {
+		if result.ID == "" && len(result.Clips) == 0 {
+			flat = append(flat, result)
+			continue
+		}
+		for _, clip := range result.Clips {
+			if clip.VideoID == "" {
+				clip.VideoID = result.ID
+			}
+			flat = append(flat, clip)
+		}
+	}
+	return flat
+}
+
+// newVideoSearchResult aggregates the clips of a video.
+func newVideoSearchResult(videoID string, clips []SearchResult) VideoSearchResult {
+	slices.SortStableFunc(clips, func(a, b SearchResult) int {
+		return cmp.Or(cmp.Compare(a.Start, b.Start), cmp.Compare(a.End, b.End))
+	})
+
+	video := VideoSearchResult{VideoID: videoID, Clips: clips}
+	var total, coveredUntil float64
+	for i, clip := range clips {
+		total += clip.Score
+		if i == 0 || clip.Score > video.MaxScore {
+			video.MaxScore = clip.Score
+		}
+		// Clips are sorted by start, so only the part after the clips seen so far is new.
+		start := clip.Start
+		if i > 0 {
+			start = max(start, coveredUntil)
+		}
+		if clip.End > start {
+			video.MatchedDuration += clip.End - start
+		}
+		if i == 0 {
+			coveredUntil = clip.End
+		} else {
+			coveredUntil = max(coveredUntil, clip.End)
+		}
+	}
+	if len(clips) > 0 {
+		video.MeanScore = total / float64(len(clips))
+	}
+	return video
+}
+
+// SceneOptions configures how clips are merged into scenes. All fields are optional.
+type SceneOptions struct {
+	// Gap is the largest gap in seconds between two clips of a scene. Zero merges
+	// only clips that touch or overlap.
+	Gap float64
+	// Padding extends every clip by this many seconds at both ends before clips
+	// are merged, so clips whose padding meets fall into the same scene.
+	Padding float64
+	// Durations maps video IDs to their duration in seconds, as found in
+	// Video.Metadata.Duration. Scenes of these videos are clamped to it.
+	Durations map[string]float64
+}
+
+// Scene is a moment of a video made of adjacent or overlapping matching clips.
+type Scene struct {
+	// VideoID identifies the video.
+	VideoID string
+	// Start and End bound the scene in seconds, including any padding.
+	Start float64
+	End   float64
+	// MaxScore is the highest score of the clips.
+	MaxScore float64
+	// MeanScore is the average score of the clips.
+	MeanScore float64
+	// Confidence is the highest confidence of the clips: "high", "medium" or "low".
+	Confidence string
+	// Clips are the clips merged into the scene in time order.
+	Clips []SearchResult
+}
+
+// confidenceRank orders the confidence levels of search results.
+var confidenceRank = map[string]int{"low": 1, "medium": 2, "high": 3}
+
+// MergeScenes merges the clips of each video whose gap is within opts.Gap into
+// scenes, whether or not the search was grouped by video. Clips are padded by
+// opts.Padding before the gaps are measured. Scenes are returned by
+// video in the order the API ranked the videos, and in time order within a video.
+// nil options merge only touching or overlapping clips.
+//
+// Example:
+//
+//	scenes := response.MergeScenes(&models.SceneOptions{
+//	    Gap:       2,
+//	    Padding:   1,
+//	    Durations: map[string]float64{video.ID: video.Metadata.Duration},
+//	})
+//	for _, scene := range scenes {
+//	    fmt.Printf("%s %.1f-%.1fs (%d clips, %s)\n",
+//	        scene.VideoID, scene.Start, scene.End, len(scene.Clips), scene.Confidence)
+//	}
+func (r *SearchResponse) MergeScenes(opts *SceneOptions) []Scene {
+	return MergeSearchScenes(r.Data, opts)
+}
+
+// MergeSearchScenes merges search results into scenes, as SearchResponse.MergeScenes
+// does. It accepts results of either shape, e.g. those collected from several pages.
+func MergeSearchScenes(results []SearchResult, opts *SceneOptions) []Scene {
+	var options SceneOptions
+	if opts != nil {
+		options = *opts
+	}
+
+	var scenes []Scene
+	for _, video := range GroupSearchResults(results) {
+		var scene *Scene
+		for _, clip := range video.Clips {
+			// Clips are padded first, so that clips brought together by their
+			// padding fall into the same scene.
+			start, end := padClip(clip, video.VideoID, options)
+			if scene != nil && start-scene.End <= options.Gap {
+				scene.End = max(scene.End, end)
+				scene.Clips = append(scene.Clips, clip)
+				continue
+			}
+			if scene != nil {
+				scenes = append(scenes, finishScene(*scene))
+			}
+			scene = &Scene{VideoID: video.VideoID, Start: start, End: end, Clips: []SearchResult{clip}}
+		}
+		if scene != nil {
+			scenes = append(scenes, finishScene(*scene))
+		}
+	}
+	return scenes
+}
+
+// padClip returns the bounds of clip extended by the padding and clamped to the
+// video, from 0 to its duration when known.
+func padClip(clip SearchResult, videoID string, options SceneOptions) (start, end float64) {
+	start = max(clip.Start-options.Padding, 0)
+	end = clip.End + options.Padding
+	if duration, ok := options.Durations[videoID]; ok && duration > 0 {
+		end = min(end, duration)
+		start = min(start, end)
+	}
+	return start, end
+}
+
+// finishScene aggregates the scores and confidence of the clips of a scene.
+func finishScene(scene Scene) Scene {
+	var total float64
+	for i, clip := range scene.Clips {
+		total += clip.Score
+		if i == 0 || clip.Score > scene.MaxScore {
+			scene.MaxScore = clip.Score
+		}
+		if confidenceRank[clip.Confidence] > confidenceRank[scene.Confidence] {
+			scene.Confidence = clip.Confidence
+		}
+	}
+	scene.MeanScore = total / float64(len(scene.Clips))
+	return scene
+}
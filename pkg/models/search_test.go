@@ -0,0 +1,191 @@
+package models
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestMergeSearchScenes(t *testing.T) {
+	clips := []SearchResult{
+		{VideoID: "video-1", Start: 0.5, End: 4, Score: 80, Confidence: "medium"},
+		{VideoID: "video-1", Start: 5.5, End: 8, Score: 90, Confidence: "high"},
+		{VideoID: "video-1", Start: 20, End: 29.5, Score: 70, Confidence: "low"},
+	}
+
+	type bounds struct{ start, end float64 }
+	tests := []struct {
+		name string
+		opts *SceneOptions
+		want []bounds
+	}{
+		{
+			name: "nil options merge only touching clips",
+			want: []bounds{{0.5, 4}, {5.5, 8}, {20, 29.5}},
+		},
+		{
+			name: "gap merges nearby clips",
+			opts: &SceneOptions{Gap: 1.5},
+			want: []bounds{{0.5, 8}, {20, 29.5}},
+		},
+		{
+			name: "padding merges clips before the gap is measured",
+			opts: &SceneOptions{Padding: 1},
+			want: []bounds{{0, 9}, {19, 30.5}},
+		},
+		{
+			name: "padding is clamped to the duration",
+			opts: &SceneOptions{Padding: 1, Durations: map[string]float64{"video-1": 30}},
+			want: []bounds{{0, 9}, {19, 30}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []bounds
+			for _, scene := range MergeSearchScenes(clips, tt.opts) {
+				got = append(got, bounds{scene.Start, scene.End})
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("scenes = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMergeSearchScenesAggregatesClips(t *testing.T) {
+	scenes := MergeSearchScenes([]SearchResult{
+		{VideoID: "video-1", Start: 0, End: 4, Score: 80, Confidence: "medium"},
+		{VideoID: "video-1", Start: 4, End: 8, Score: 90, Confidence: "high"},
+	}, nil)
+	if len(scenes) != 1 {
+		t.Fatalf("got %d scenes, want 1", len(scenes))
+	}
+	scene := scenes[0]
+	if scene.MaxScore != 90 || scene.MeanScore != 85 || scene.Confidence != "high" || len(scene.Clips) != 2 {
+		t.Errorf("scene = %+v", scene)
+	}
+}
+
+func TestNewVideoSearchResult(t *testing.T) {
+	tests := []struct {
+		name        string
+		clips       []SearchResult
+		wantMax     float64
+		wantMean    float64
+		wantMatched float64
+	}{
+		{
+			name: "overlapping clips counted once",
+			clips: []SearchResult{
+				{Start: 20, End: 25, Score: 70},
+				{Start: 5, End: 15, Score: 90},
+				{Start: 0, End: 10, Score: 80},
+			},
+			wantMax: 90, wantMean: 80, wantMatched: 20,
+		},
+		{
+			name: "clip inside another",
+			clips: []SearchResult{
+				{Start: 0, End: 20, Score: 50},
+				{Start: 5, End: 10, Score: 60},
+			},
+			wantMax: 60, wantMean: 55, wantMatched: 20,
+		},
+		{
+			name:    "negative scores",
+			clips:   []SearchResult{{Start: 0, End: 1, Score: -2}, {Start: 3, End: 4, Score: -4}},
+			wantMax: -2, wantMean: -3, wantMatched: 2,
+		},
+		{
+			name: "no clips",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			video := newVideoSearchResult("video-1", tt.clips)
+			if video.MaxScore != tt.wantMax || video.MeanScore != tt.wantMean || video.MatchedDuration != tt.wantMatched {
+				t.Errorf("max %v, mean %v, matched %v; want %v, %v, %v",
+					video.MaxScore, video.MeanScore, video.MatchedDuration, tt.wantMax, tt.wantMean, tt.wantMatched)
+			}
+			for i := 1; i < len(video.Clips); i++ {
+				if video.Clips[i].Start < video.Clips[i-1].Start {
+					t.Errorf("clips not in time order: %+v", video.Clips)
+				}
+			}
+		})
+	}
+}
+
+func TestSearchResponseShapes(t *testing.T) {
+	type aggregate struct {
+		videoID             string
+		clips               int
+		max, mean, duration float64
+	}
+	tests := []struct {
+		name        string
+		body        string
+		wantGrouped bool
+		wantResults []string
+		wantVideos  []aggregate
+	}{
+		{
+			name: "flat",
+			body: `{"data":[
+				{"video_id":"video-1","score":80,"start":0,"end":10},
+				{"video_id":"video-2","score":60,"start":0,"end":5},
+				{"video_id":"video-1","score":90,"start":5,"end":15}
+			]}`,
+			wantResults: []string{"video-1", "video-2", "video-1"},
+			wantVideos: []aggregate{
+				{videoID: "video-1", clips: 2, max: 90, mean: 85, duration: 15},
+				{videoID: "video-2", clips: 1, max: 60, mean: 60, duration: 5},
+			},
+		},
+		{
+			name: "grouped",
+			body: `{"data":[
+				{"id":"video-1","clips":[
+					{"score":80,"start":0,"end":10},
+					{"score":90,"start":5,"end":15}
+				]},
+				{"id":"video-2","clips":[{"score":60,"start":0,"end":5}]},
+				{"id":"video-3","clips":[]}
+			]}`,
+			wantGrouped: true,
+			wantResults: []string{"video-1", "video-1", "video-2"},
+			wantVideos: []aggregate{
+				{videoID: "video-1", clips: 2, max: 90, mean: 85, duration: 15},
+				{videoID: "video-2", clips: 1, max: 60, mean: 60, duration: 5},
+				{videoID: "video-3"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var response SearchResponse
+			if err := json.Unmarshal([]byte(tt.body), &response); err != nil {
+				t.Fatal(err)
+			}
+			if response.IsGrouped() != tt.wantGrouped {
+				t.Errorf("IsGrouped() = %v, want %v", response.IsGrouped(), tt.wantGrouped)
+			}
+
+			var results []string
+			for _, result := range response.Results() {
+				results = append(results, result.VideoID)
+			}
+			if !reflect.DeepEqual(results, tt.wantResults) {
+				t.Errorf("Results() videos = %v, want %v", results, tt.wantResults)
+			}
+
+			var videos []aggregate
+			for _, video := range response.GroupByVideo() {
+				videos = append(videos, aggregate{video.VideoID, len(video.Clips), video.MaxScore, video.MeanScore, video.MatchedDuration})
+			}
+			if !reflect.DeepEqual(videos, tt.wantVideos) {
+				t.Errorf("GroupByVideo() = %+v, want %+v", videos, tt.wantVideos)
+			}
+		})
+	}
+}
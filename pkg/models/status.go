@@ -0,0 +1,70 @@
+package models
+
+// TaskStatus is the processing state of a video indexing task. A task moves
+// through the states in the order they are declared below until it is ready
+// or fails.
+type TaskStatus string
+
+const (
+	// TaskStatusValidating means the video is being checked against the index requirements.
+	TaskStatusValidating TaskStatus = "validating"
+	// TaskStatusPending means the task is waiting for a worker to be assigned.
+	TaskStatusPending TaskStatus = "pending"
+	// TaskStatusQueued means the task is waiting in line for indexing.
+	TaskStatusQueued TaskStatus = "queued"
+	// TaskStatusIndexing means the video is being indexed.
+	TaskStatusIndexing TaskStatus = "indexing"
+	// TaskStatusReady means the video was indexed and can be searched and analyzed.
+	TaskStatusReady TaskStatus = "ready"
+	// TaskStatusFailed means the video could not be indexed.
+	TaskStatusFailed TaskStatus = "failed"
+	// TaskStatusError is reported by older API versions instead of TaskStatusFailed.
+	TaskStatusError TaskStatus = "error"
+)
+
+// taskStatusOrder ranks the states by their position in the task lifecycle.
+var taskStatusOrder = map[TaskStatus]int{
+	TaskStatusValidating: 1,
+	TaskStatusPending:    2,
+	TaskStatusQueued:     3,
+	TaskStatusIndexing:   4,
+	TaskStatusReady:      5,
+	TaskStatusFailed:     5,
+	TaskStatusError:      5,
+}
+
+// IsKnown reports whether s is one of the statuses declared by the SDK.
+func (s TaskStatus) IsKnown() bool {
+	_, ok := taskStatusOrder[s]
+	return ok
+}
+
+// IsTerminal reports whether the task has finished and its status no longer changes.
+func (s TaskStatus) IsTerminal() bool {
+	return s == TaskStatusReady || s == TaskStatusFailed || s == TaskStatusError
+}
+
+// IsSuccess reports whether the task finished with the video indexed.
+func (s TaskStatus) IsSuccess() bool {
+	return s == TaskStatusReady
+}
+
+// CanTransitionTo reports whether a task in status s may later be seen in status
+// next. Tasks only move forward and may skip states between two observations;
+// a terminal status never changes. Transitions involving statuses unknown to
+// the SDK are allowed, so that new server states do not break callers.
+func (s TaskStatus) CanTransitionTo(next TaskStatus) bool {
+	if s == next {
+		return true
+	}
+	if s.IsTerminal() {
+		return false
+	}
+	from, fromKnown := taskStatusOrder[s]
+	to, toKnown := taskStatusOrder[next]
+	return !fromKnown || !toKnown || to > from
+}
+
+func (s TaskStatus) String() string {
+	return string(s)
+}
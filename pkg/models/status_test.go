@@ -0,0 +1,57 @@
+package models
+
+import "testing"
+
+func TestTaskStatusPredicates(t *testing.T) {
+	tests := []struct {
+		status   TaskStatus
+		terminal bool
+		success  bool
+	}{
+		{TaskStatusValidating, false, false},
+		{TaskStatusPending, false, false},
+		{TaskStatusQueued, false, false},
+		{TaskStatusIndexing, false, false},
+		{TaskStatusReady, true, true},
+		{TaskStatusFailed, true, false},
+		{TaskStatusError, true, false},
+		{"uploading", false, false},
+	}
+	for _, tt := range tests {
+		t.Run(string(tt.status), func(t *testing.T) {
+			if got := tt.status.IsTerminal(); got != tt.terminal {
+				t.Errorf("IsTerminal() = %v, want %v", got, tt.terminal)
+			}
+			if got := tt.status.IsSuccess(); got != tt.success {
+				t.Errorf("IsSuccess() = %v, want %v", got, tt.success)
+			}
+			if got, want := tt.status.IsKnown(), tt.status != "uploading"; got != want {
+				t.Errorf("IsKnown() = %v, want %v", got, want)
+			}
+		})
+	}
+}
+
+func TestTaskStatusCanTransitionTo(t *testing.T) {
+	tests := []struct {
+		from, to TaskStatus
+		want     bool
+	}{
+		{"", TaskStatusValidating, true},
+		{TaskStatusValidating, TaskStatusIndexing, true},
+		{TaskStatusPending, TaskStatusReady, true},
+		{TaskStatusIndexing, TaskStatusFailed, true},
+		{TaskStatusIndexing, TaskStatusIndexing, true},
+		{TaskStatusIndexing, TaskStatusQueued, false},
+		{TaskStatusReady, TaskStatusIndexing, false},
+		{TaskStatusFailed, TaskStatusReady, false},
+		{TaskStatusReady, TaskStatusReady, true},
+		{TaskStatusQueued, "uploading", true},
+		{"uploading", TaskStatusValidating, true},
+	}
+	for _, tt := range tests {
+		if got := tt.from.CanTransitionTo(tt.to); got != tt.want {
+			t.Errorf("%q.CanTransitionTo(%q) = %v, want %v", tt.from, tt.to, got, tt.want)
+		}
+	}
+}
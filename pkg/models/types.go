@@ -1,10 +1,12 @@
 package models
 
+import "time"
+
 // Core data types and models for the TwelveLabs Go SDK
 
 type Task struct {
 	ID             string                 `json:"_id"`
-	Status         string                 `json:"status"`
+	Status         TaskStatus             `json:"status"`
 	VideoID        string                 `json:"video_id"`
 	IndexID        string                 `json:"index_id"`
 	SystemMetadata map[string]interface{} `json:"system_metadata"`
@@ -33,10 +35,22 @@ type Video struct {
 		Height   int     `json:"height"`
 		Width    int     `json:"width"`
 	} `json:"system_metadata"`
-	CreatedAt string `json:"created_at"`
-	UpdatedAt string `json:"updated_at"`
-}
-
+	UserMetadata map[string]interface{} `json:"user_metadata,omitempty"`
+	CreatedAt    string                 `json:"created_at"`
+	UpdatedAt    string                 `json:"updated_at"`
+	IndexedAt    string                 `json:"indexed_at,omitempty"`
+	// HLS is set for videos uploaded with video streaming enabled.
+	HLS *VideoHLS `json:"hls,omitempty"`
+	// Embedding, Transcription and VisualText are only set when requested with VideoRetrieveOptions.
+	Embedding     *VideoEmbeddings   `json:"embedding,omitempty"`
+	Transcription []VideoTextSegment `json:"transcription,omitempty"`
+	VisualText    []VideoTextSegment `json:"-"`
+}
+
+// SearchResult is a clip matching a search. When the search is grouped by video,
+// each result is instead a video holding its matching clips: ID is set to the
+// video ID and Clips lists the clips, and the clip fields are empty. See
+// SearchResponse.Results and SearchResponse.GroupByVideo to handle both shapes.
 type SearchResult struct {
 	VideoID       string  `json:"video_id"`
 	Score         float64 `json:"score"`
@@ -46,6 +60,10 @@ type SearchResult struct {
 	ThumbnailURL  string  `json:"thumbnail_url,omitempty"`
 	Transcription string  `json:"transcription,omitempty"`
 	//Metadata      map[string]interface{} `json:"metadata,omitempty"`
+
+	// ID and Clips are set instead of the clip fields when the search is grouped by video.
+	ID    string         `json:"id,omitempty"`
+	Clips []SearchResult `json:"clips,omitempty"`
 }
 
 // Search pool information
@@ -56,16 +74,25 @@ type SearchPool struct {
 }
 
 // Request types
+//
+// Requests sent as multipart forms are encoded from their json tags. A form tag
+// overrides the field name; its "file" option marks a local path uploaded as a
+// file part, and its "media=part" option leaves the field out when a media source
+// is set for that part, so that a source takes precedence over a media URL.
 type TasksCreateRequest struct {
 	IndexID             string            `json:"index_id"`
-	VideoFile           string            `json:"video_file,omitempty"`
-	VideoURL            string            `json:"video_url,omitempty"`
+	VideoFile           string            `json:"video_file,omitempty" form:"video_file,file"`
+	VideoURL            string            `json:"video_url,omitempty" form:"video_url,omitempty,media=video_file"`
 	VideoStartOffsetSec int               `json:"video_start_offset_sec,omitempty"`
 	VideoEndOffsetSec   int               `json:"video_end_offset_sec,omitempty"`
 	VideoClipLength     int               `json:"video_clip_length,omitempty"`
 	VideoEmbeddingScope []string          `json:"video_embedding_scope,omitempty"`
 	EnableVideoStream   bool              `json:"enable_video_stream,omitempty"`
 	UserMetadata        map[string]string `json:"user_metadata,omitempty"`
+	// VideoSource uploads the video from a reader or memory; it takes precedence over VideoFile.
+	VideoSource *MediaSource `json:"-" form:"video_file"`
+	// OnProgress is called periodically while VideoFile is uploaded.
+	OnProgress ProgressFunc `json:"-"`
 }
 
 type IndexCreateRequest struct {
@@ -83,12 +110,19 @@ type EmbedRequest struct {
 	VideoID      string `json:"video_id,omitempty"`
 	Text         string `json:"text,omitempty"`
 	TextTruncate string `json:"text_truncate,omitempty"`
-	ImageURL     string `json:"image_url,omitempty"`
-	ImageFile    string `json:"image_file,omitempty"`
-	AudioURL     string `json:"audio_url,omitempty"`
-	AudioFile    string `json:"audio_file,omitempty"`
-	VideoURL     string `json:"video_url,omitempty"`
-	VideoFile    string `json:"video_file,omitempty"`
+	ImageURL     string `json:"image_url,omitempty" form:"image_url,omitempty,media=image_file"`
+	ImageFile    string `json:"image_file,omitempty" form:"image_file,file"`
+	AudioURL     string `json:"audio_url,omitempty" form:"audio_url,omitempty,media=audio_file"`
+	AudioFile    string `json:"audio_file,omitempty" form:"audio_file,file"`
+	VideoURL     string `json:"video_url,omitempty" form:"video_url,omitempty,media=video_file"`
+	VideoFile    string `json:"video_file,omitempty" form:"video_file,file"`
+	// ImageSource, AudioSource and VideoSource upload media from a reader or memory.
+	// Each takes precedence over the corresponding file path.
+	ImageSource *MediaSource `json:"-" form:"image_file"`
+	AudioSource *MediaSource `json:"-" form:"audio_file"`
+	VideoSource *MediaSource `json:"-" form:"video_file"`
+	// OnProgress is called periodically while a media file is uploaded.
+	OnProgress ProgressFunc `json:"-"`
 }
 
 type VideoUpdateRequest struct {
@@ -96,12 +130,24 @@ type VideoUpdateRequest struct {
 	UserMetadata map[string]string `json:"user_metadata,omitempty"`
 }
 
+// Values of SearchRequest.GroupBy.
+const (
+	SearchGroupByClip  = "clip"
+	SearchGroupByVideo = "video"
+)
+
+// Values of SearchRequest.Operator.
+const (
+	SearchOperatorOr  = "or"
+	SearchOperatorAnd = "and"
+)
+
 type SearchQueryRequest struct {
 	IndexID               string   `json:"index_id"`
 	QueryText             string   `json:"query_text,omitempty"`
 	QueryMediaType        string   `json:"query_media_type,omitempty"`
-	QueryMediaFile        string   `json:"query_media_file,omitempty"`
-	QueryMediaURL         string   `json:"query_media_url,omitempty"`
+	QueryMediaFile        string   `json:"query_media_file,omitempty" form:"query_media_file,file"`
+	QueryMediaURL         string   `json:"query_media_url,omitempty" form:"query_media_url,omitempty,media=query_media_file"`
 	ConversationOption    string   `json:"conversation_option,omitempty"`
 	Filter                string   `json:"filter,omitempty"`
 	SearchOptions         []string `json:"search_options,omitempty"`
@@ -109,14 +155,28 @@ type SearchQueryRequest struct {
 	SortOption            string   `json:"sort_option,omitempty"`
 	AdjustConfidenceLevel float64  `json:"adjust_confidence_level,omitempty"`
 	IncludeClips          bool     `json:"include_clips,omitempty"`
+	// GroupBy groups the results by SearchGroupByClip (the API default) or SearchGroupByVideo.
+	GroupBy string `json:"group_by,omitempty"`
+	// Operator combines the matches of several SearchOptions: SearchOperatorOr (the API default) or SearchOperatorAnd.
+	Operator string `json:"operator,omitempty"`
+	// TranscriptionOptions selects how the query is matched against the audio
+	// transcription when SearchOptions includes "transcription": "lexical" and/or "semantic".
+	TranscriptionOptions []string `json:"transcription_options,omitempty"`
+	// PageLimit is the number of results per page.
+	PageLimit int `json:"page_limit,omitempty"`
+	// QueryMediaSource uploads the query media from a reader or memory; it takes
+	// precedence over QueryMediaFile.
+	QueryMediaSource *MediaSource `json:"-" form:"query_media_file"`
+	// OnProgress is called periodically while QueryMediaFile is uploaded.
+	OnProgress ProgressFunc `json:"-"`
 }
 
 type SearchRequest struct {
 	IndexID               string   `json:"index_id"`
 	QueryText             string   `json:"query_text,omitempty"`
 	QueryMediaType        string   `json:"query_media_type,omitempty"`
-	QueryMediaFile        string   `json:"query_media_file,omitempty"`
-	QueryMediaURL         string   `json:"query_media_url,omitempty"`
+	QueryMediaFile        string   `json:"query_media_file,omitempty" form:"query_media_file,file"`
+	QueryMediaURL         string   `json:"query_media_url,omitempty" form:"query_media_url,omitempty,media=query_media_file"`
 	ConversationOption    string   `json:"conversation_option,omitempty"`
 	Filter                string   `json:"filter,omitempty"`
 	SearchOptions         []string `json:"search_options,omitempty"`
@@ -124,9 +184,37 @@ type SearchRequest struct {
 	SortOption            string   `json:"sort_option,omitempty"`
 	AdjustConfidenceLevel float64  `json:"adjust_confidence_level,omitempty"`
 	IncludeClips          bool     `json:"include_clips,omitempty"`
-	PageLimit             int      `json:"page_limit,omitempty"`
-	PageToken             string   `json:"page_token,omitempty"`
-}
+	// GroupBy groups the results by SearchGroupByClip (the API default) or SearchGroupByVideo.
+	GroupBy string `json:"group_by,omitempty"`
+	// Operator combines the matches of several SearchOptions: SearchOperatorOr (the API default) or SearchOperatorAnd.
+	Operator string `json:"operator,omitempty"`
+	// TranscriptionOptions selects how the query is matched against the audio
+	// transcription when SearchOptions includes "transcription": "lexical" and/or "semantic".
+	TranscriptionOptions []string `json:"transcription_options,omitempty"`
+	PageLimit            int      `json:"page_limit,omitempty"`
+	PageToken            string   `json:"page_token,omitempty"`
+	// QueryMediaSource uploads the query media from a reader or memory; it takes
+	// precedence over QueryMediaFile.
+	QueryMediaSource *MediaSource `json:"-" form:"query_media_file"`
+	// OnProgress is called periodically while QueryMediaFile is uploaded.
+	OnProgress ProgressFunc `json:"-"`
+}
+
+// UploadProgress reports how far a media upload has progressed.
+type UploadProgress struct {
+	// BytesSent is the number of request body bytes sent so far.
+	BytesSent int64
+	// TotalBytes is the size of the request body.
+	TotalBytes int64
+	// Throughput is the average upload speed in bytes per second.
+	Throughput float64
+	// ETA is the estimated time until the upload completes; zero when unknown.
+	ETA time.Duration
+}
+
+// ProgressFunc receives upload progress updates. It is called from the goroutine
+// sending the request and should return quickly.
+type ProgressFunc func(UploadProgress)
 
 // Response types
 type SearchResponse struct {
@@ -135,8 +223,12 @@ type SearchResponse struct {
 	PageInfo   *PageInfo      `json:"page_info,omitempty"`
 }
 
+// PageInfo describes the position of a page in its listing. Listings are paginated
+// by page number (Page and TotalPage) and search results by token.
 type PageInfo struct {
 	LimitPerPage  int    `json:"limit_per_page"`
+	Page          int    `json:"page,omitempty"`
+	TotalPage     int    `json:"total_page,omitempty"`
 	TotalResults  int    `json:"total_results"`
 	PageExpiresAt string `json:"page_expires_at"`
 	NextPageToken string `json:"next_page_token,omitempty"`
@@ -190,6 +282,9 @@ type EmbeddingSegment struct {
 	Float          []float64 `json:"float"`
 	StartOffsetSec *float64  `json:"start_offset_sec,omitempty"`
 	EndOffsetSec   *float64  `json:"end_offset_sec,omitempty"`
+	// EmbeddingOption and EmbeddingScope are set on the embeddings of indexed videos.
+	EmbeddingOption string `json:"embedding_option,omitempty"`
+	EmbeddingScope  string `json:"embedding_scope,omitempty"`
 }
 
 // Legacy EmbeddingData struct for backward compatibility
@@ -271,6 +366,56 @@ type AnalyzeStreamResponse struct {
 	} `json:"metadata,omitempty"`
 }
 
+// Upload session types
+
+type UploadSessionCreateRequest struct {
+	Filename  string `json:"filename"`
+	Type      string `json:"type"` // video, image, audio
+	TotalSize int64  `json:"total_size"`
+}
+
+// UploadSession is a chunked upload session. Chunks are numbered from 1 and
+// uploaded with PUT requests to pre-signed URLs.
+type UploadSession struct {
+	UploadID    string      `json:"upload_id"`
+	AssetID     string      `json:"asset_id"`
+	ChunkSize   int64       `json:"chunk_size"`
+	TotalChunks int         `json:"total_chunks"`
+	UploadURLs  []UploadURL `json:"upload_urls,omitempty"`
+	ExpiresAt   string      `json:"expires_at,omitempty"`
+}
+
+type UploadURL struct {
+	ChunkIndex int    `json:"chunk_index"`
+	URL        string `json:"url"`
+	ExpiresAt  string `json:"url_expires_at,omitempty"`
+}
+
+// CompletedChunk identifies an uploaded chunk by the ETag returned by storage.
+type CompletedChunk struct {
+	ChunkIndex int    `json:"chunk_index"`
+	Proof      string `json:"proof"`
+	ProofType  string `json:"proof_type"` // etag
+	ChunkSize  int64  `json:"chunk_size"`
+}
+
+type UploadSessionStatus struct {
+	UploadID        string           `json:"upload_id"`
+	AssetID         string           `json:"asset_id"`
+	Status          string           `json:"status"` // active, completed, expired
+	TotalChunks     int              `json:"total_chunks"`
+	CompletedChunks []CompletedChunk `json:"completed_chunks,omitempty"`
+}
+
+// Asset is an uploaded media file that can be indexed.
+type Asset struct {
+	ID        string `json:"_id"`
+	Status    string `json:"status"` // waiting, processing, ready, failed
+	Filename  string `json:"filename"`
+	FileType  string `json:"file_type,omitempty"`
+	CreatedAt string `json:"created_at"`
+}
+
 // Helper methods for EmbedResponse to provide consistent access to embeddings
 func (e *EmbedResponse) GetEmbeddings() []float64 {
 	// Return the appropriate embeddings based on which type was created
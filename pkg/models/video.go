@@ -0,0 +1,64 @@
+package models
+
+import (
+	"net/url"
+	"strconv"
+)
+
+// VideoHLS describes the HLS stream of a video. It is only available for videos
+// uploaded with EnableVideoStream set.
+type VideoHLS struct {
+	// VideoURL is the URL of the HLS playlist.
+	VideoURL string `json:"video_url,omitempty"`
+	// ThumbnailURLs are thumbnails of the video generated with the stream.
+	ThumbnailURLs []string `json:"thumbnail_urls,omitempty"`
+	// Status is the processing state of the stream, e.g. "PROCESSING" or "COMPLETE".
+	Status string `json:"status,omitempty"`
+	// UpdatedAt is when the stream was last updated.
+	UpdatedAt string `json:"updated_at,omitempty"`
+}
+
+// VideoEmbeddings holds the embeddings of an indexed video.
+type VideoEmbeddings struct {
+	ModelName      string                `json:"model_name,omitempty"`
+	VideoEmbedding *VideoEmbeddingResult `json:"video_embedding,omitempty"`
+}
+
+// VideoTextSegment is a piece of text found in a video, such as a line of its
+// transcription or text recognized in its frames, with the time it appears.
+type VideoTextSegment struct {
+	// Start is the time the text starts, in seconds.
+	Start float64 `json:"start"`
+	// End is the time the text ends, in seconds.
+	End float64 `json:"end"`
+	// Value is the text.
+	Value string `json:"value"`
+}
+
+// VideoRetrieveOptions selects the optional parts of a video to retrieve. All fields are optional.
+type VideoRetrieveOptions struct {
+	// EmbeddingOptions selects the embeddings to include: "visual-text", "visual-image" or "audio".
+	// The index must have been created with a model that generates them.
+	EmbeddingOptions []string
+	// Transcription includes the transcription of the video.
+	Transcription bool
+	// VisualText includes the text recognized in the frames of the video. It is
+	// retrieved with a separate request.
+	VisualText bool
+}
+
+// Values encodes the options as query parameters. VisualText is not a query
+// parameter and is left out.
+func (o *VideoRetrieveOptions) Values() url.Values {
+	values := url.Values{}
+	if o == nil {
+		return values
+	}
+	for _, option := range o.EmbeddingOptions {
+		values.Add("embedding_option", option)
+	}
+	if o.Transcription {
+		values.Set("transcription", strconv.FormatBool(true))
+	}
+	return values
+}
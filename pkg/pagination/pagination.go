@@ -0,0 +1,213 @@
+// Package pagination provides a common page type for the SDK's listing and search
+// endpoints, and a pager that walks the pages with optional background prefetching.
+package pagination
+
+import (
+	"context"
+	"errors"
+	"iter"
+
+	"github.com/favourthemaster/twelvelabs-go-sdk/pkg/models"
+)
+
+// ErrNoPage is returned by Page.Next and Page.Prev when there is no such page.
+var ErrNoPage = errors.New("no such page")
+
+// Cursor identifies a page. Listings are paginated by page number and search
+// results by token; the zero Cursor identifies no page.
+type Cursor struct {
+	// Page is the page number, starting at 1.
+	Page int
+	// Token is the page token of search results.
+	Token string
+}
+
+// IsZero reports whether the cursor identifies no page.
+func (c Cursor) IsZero() bool {
+	return c.Page == 0 && c.Token == ""
+}
+
+// FetchFunc retrieves the page identified by a cursor.
+type FetchFunc[T any] func(ctx context.Context, cursor Cursor) (*Page[T], error)
+
+// Page is a single page of results together with the cursors of its neighbours.
+type Page[T any] struct {
+	// Items are the results on this page.
+	Items []T
+	// TotalResults is the number of results across all pages, when reported.
+	TotalResults int
+	// TotalPages is the number of pages, or zero for token-paginated results.
+	TotalPages int
+	// Cursor identifies this page.
+	Cursor Cursor
+	// NextCursor identifies the next page; it is zero on the last page.
+	NextCursor Cursor
+	// PrevCursor identifies the previous page; it is zero on the first page.
+	PrevCursor Cursor
+
+	fetch FetchFunc[T]
+}
+
+// New builds a page from the items and page info of a response. current is the
+// cursor the page was fetched with and fetch retrieves the neighbouring pages.
+func New[T any](items []T, info *models.PageInfo, current Cursor, fetch FetchFunc[T]) *Page[T] {
+	page := &Page[T]{Items: items, Cursor: current, fetch: fetch}
+	if info == nil {
+		return page
+	}
+	page.TotalResults = info.TotalResults
+	page.TotalPages = info.TotalPage
+	if info.NextPageToken != "" || info.PrevPageToken != "" {
+		page.NextCursor = Cursor{Token: info.NextPageToken}
+		page.PrevCursor = Cursor{Token: info.PrevPageToken}
+		return page
+	}
+	number := info.Page
+	if number == 0 {
+		number = max(current.Page, 1)
+	}
+	page.Cursor.Page = number
+	if number < info.TotalPage && len(items) > 0 {
+		page.NextCursor = Cursor{Page: number + 1}
+	}
+	if number > 1 {
+		page.PrevCursor = Cursor{Page: number - 1}
+	}
+	return page
+}
+
+// HasNext reports whether there is a page after this one.
+func (p *Page[T]) HasNext() bool {
+	return !p.NextCursor.IsZero()
+}
+
+// HasPrev reports whether there is a page before this one.
+func (p *Page[T]) HasPrev() bool {
+	return !p.PrevCursor.IsZero()
+}
+
+// Next retrieves the page after this one. It returns ErrNoPage on the last page.
+func (p *Page[T]) Next(ctx context.Context) (*Page[T], error) {
+	if !p.HasNext() || p.fetch == nil {
+		return nil, ErrNoPage
+	}
+	return p.fetch(ctx, p.NextCursor)
+}
+
+// Prev retrieves the page before this one. It returns ErrNoPage on the first page.
+func (p *Page[T]) Prev(ctx context.Context) (*Page[T], error) {
+	if !p.HasPrev() || p.fetch == nil {
+		return nil, ErrNoPage
+	}
+	return p.fetch(ctx, p.PrevCursor)
+}
+
+// Pager returns a pager that walks the pages from this one onwards.
+func (p *Page[T]) Pager() *Pager[T] {
+	return NewPager(func(context.Context) (*Page[T], error) { return p, nil })
+}
+
+// Pager walks a sequence of pages, starting from a first page fetched when the
+// walk begins. Pages are fetched lazily unless Prefetch is set.
+type Pager[T any] struct {
+	// Prefetch is the number of pages fetched in the background ahead of the page
+	// being consumed. Zero fetches each page only when the walk reaches it.
+	Prefetch int
+
+	first func(context.Context) (*Page[T], error)
+}
+
+// NewPager creates a pager whose walk starts with the page returned by first.
+func NewPager[T any](first func(context.Context) (*Page[T], error)) *Pager[T] {
+	return &Pager[T]{first: first}
+}
+
+// Pages returns an iterator over the pages. The iteration stops after yielding an error.
+func (p *Pager[T]) Pages(ctx context.Context) iter.Seq2[*Page[T], error] {
+	if p.Prefetch > 0 {
+		return p.prefetched(ctx)
+	}
+	return func(yield func(*Page[T], error) bool) {
+		page, err := p.first(ctx)
+		for {
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			if !yield(page, nil) || !page.HasNext() {
+				return
+			}
+			page, err = page.Next(ctx)
+		}
+	}
+}
+
+// prefetched walks the pages with a goroutine that fetches up to Prefetch pages
+// ahead. The goroutine stops when the iteration ends.
+func (p *Pager[T]) prefetched(ctx context.Context) iter.Seq2[*Page[T], error] {
+	type result struct {
+		page *Page[T]
+		err  error
+	}
+	return func(yield func(*Page[T], error) bool) {
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+
+		// The goroutine holds one page while blocked on the channel, so the buffer
+		// is one smaller than the number of pages fetched ahead.
+		results := make(chan result, p.Prefetch-1)
+		go func() {
+			defer close(results)
+			page, err := p.first(ctx)
+			for {
+				select {
+				case results <- result{page, err}:
+				case <-ctx.Done():
+					return
+				}
+				if err != nil || !page.HasNext() {
+					return
+				}
+				page, err = page.Next(ctx)
+			}
+		}()
+
+		for r := range results {
+			if !yield(r.page, r.err) || r.err != nil {
+				return
+			}
+		}
+	}
+}
+
+// Items returns an iterator over the items of every page. The iteration stops
+// after yielding an error.
+func (p *Pager[T]) Items(ctx context.Context) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		for page, err := range p.Pages(ctx) {
+			if err != nil {
+				var zero T
+				yield(zero, err)
+				return
+			}
+			for _, item := range page.Items {
+				if !yield(item, nil) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// All collects the items of every page. On error it returns the items collected
+// so far along with the error.
+func (p *Pager[T]) All(ctx context.Context) ([]T, error) {
+	var items []T
+	for item, err := range p.Items(ctx) {
+		if err != nil {
+			return items, err
+		}
+		items = append(items, item)
+	}
+	return items, nil
+}
@@ -0,0 +1,162 @@
+// Package poll provides the polling loop shared by the SDK's wait operations,
+// such as waiting for an indexing task or an embedding task to finish.
+package poll
+
+import (
+	"context"
+	stderrors "errors"
+	"log/slog"
+	"time"
+
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/favourthemaster/twelvelabs-go-sdk/pkg/errors"
+	"github.com/favourthemaster/twelvelabs-go-sdk/pkg/telemetry"
+)
+
+// Default polling settings.
+const (
+	DefaultInterval    = 5 * time.Second
+	DefaultMaxInterval = 30 * time.Second
+	DefaultMultiplier  = 1.5
+	DefaultMaxErrors   = 3
+)
+
+// Poller fetches a resource until it reaches a terminal state. The delay between
+// two fetches starts at Interval and grows by Multiplier up to MaxInterval while
+// the state stays the same; it is reset to Interval whenever the state changes.
+// All waits end as soon as the context is done.
+type Poller[T any] struct {
+	// Interval is the delay after the first fetch. Defaults to DefaultInterval.
+	Interval time.Duration
+	// MaxInterval caps the delay between fetches. Defaults to DefaultMaxInterval,
+	// or to Interval when that is larger.
+	MaxInterval time.Duration
+	// Multiplier is the growth factor of the delay. Defaults to DefaultMultiplier;
+	// use 1 for a fixed interval.
+	Multiplier float64
+	// MaxErrors is the number of consecutive failed fetches tolerated before the
+	// poll fails. Errors that cannot succeed on retry, such as 404 responses, fail
+	// the poll immediately. Defaults to DefaultMaxErrors.
+	MaxErrors int
+	// Done reports whether a state is terminal. It is required.
+	Done func(T) bool
+	// State returns the value compared between fetches to detect changes. If nil,
+	// OnChange is never called and the delay is never reset.
+	State func(T) string
+	// OnChange is called when the state changes, including for the first fetch
+	// with an empty previous state. Returning an error stops the poll with it.
+	OnChange func(previous string, current T) error
+	// OnPoll is called after every successful fetch. Returning an error stops the poll with it.
+	OnPoll func(T) error
+	// Logger receives a warning for every failed fetch that is retried.
+	Logger *slog.Logger
+}
+
+// Poll calls fetch until Done reports a terminal state and returns that state.
+// It fails with the context error when ctx is done, with the last fetch error
+// after MaxErrors consecutive failures, or with the error of a hook.
+func (p *Poller[T]) Poll(ctx context.Context, fetch func(context.Context) (T, error)) (result T, err error) {
+	interval := p.Interval
+	if interval <= 0 {
+		interval = DefaultInterval
+	}
+	maxInterval := p.MaxInterval
+	if maxInterval <= 0 {
+		maxInterval = max(DefaultMaxInterval, interval)
+	}
+	multiplier := p.Multiplier
+	if multiplier < 1 {
+		multiplier = DefaultMultiplier
+	}
+	maxErrors := p.MaxErrors
+	if maxErrors <= 0 {
+		maxErrors = DefaultMaxErrors
+	}
+
+	attempts := 0
+	defer func() {
+		trace.SpanFromContext(ctx).SetAttributes(telemetry.AttrPollAttempts.Int(attempts))
+	}()
+
+	var state string
+	first, failures, delay := true, 0, interval
+	for {
+		attempts++
+		current, err := fetch(ctx)
+		if err != nil {
+			if ctx.Err() != nil {
+				return result, ctx.Err()
+			}
+			failures++
+			if failures >= maxErrors || !Transient(err) {
+				return result, err
+			}
+			if p.Logger != nil {
+				p.Logger.Warn("poll failed, retrying",
+					slog.Int("consecutive_errors", failures), slog.Any("error", err))
+			}
+		} else {
+			failures = 0
+			result = current
+
+			if p.State != nil {
+				next := p.State(current)
+				if first || next != state {
+					if p.OnChange != nil {
+						if err := p.OnChange(state, current); err != nil {
+							return result, err
+						}
+					}
+					if !first {
+						delay = interval
+					}
+					state, first = next, false
+				}
+			}
+			if p.OnPoll != nil {
+				if err := p.OnPoll(current); err != nil {
+					return result, err
+				}
+			}
+			if p.Done(current) {
+				return result, nil
+			}
+		}
+
+		if err := Sleep(ctx, delay); err != nil {
+			return result, err
+		}
+		delay = min(time.Duration(float64(delay)*multiplier), maxInterval)
+	}
+}
+
+// Transient reports whether a failed fetch may succeed when tried again. Requests
+// rejected as invalid, unauthorized or for a missing resource fail the same way
+// every time; other errors, including network failures, are assumed to be transient.
+func Transient(err error) bool {
+	for _, permanent := range []error{
+		errors.ErrBadRequest, errors.ErrUnauthorized, errors.ErrForbidden,
+		errors.ErrNotFound, errors.ErrValidation, errors.ErrRequest,
+	} {
+		if stderrors.Is(err, permanent) {
+			return false
+		}
+	}
+	return true
+}
+
+// Sleep waits for d or until ctx is done, whichever comes first.
+func Sleep(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,363 @@
+// Package search provides helpers for building search requests, starting with a
+// typed builder for the filter of a search or of the videos listing.
+package search
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/favourthemaster/twelvelabs-go-sdk/pkg/errors"
+)
+
+// Fields of the system metadata of a video that can be filtered on. Use
+// UserMetadata for the fields set with the video's user metadata.
+const (
+	FieldID       = "id"
+	FieldFilename = "filename"
+	FieldDuration = "duration"
+	FieldWidth    = "width"
+	FieldHeight   = "height"
+	FieldSize     = "size"
+	FieldFPS      = "fps"
+)
+
+// userMetadataPrefix starts the name of every user metadata field.
+const userMetadataPrefix = "user_metadata."
+
+// numericFields are the system fields holding numbers; the others hold strings.
+var numericFields = map[string]bool{
+	FieldDuration: true,
+	FieldWidth:    true,
+	FieldHeight:   true,
+	FieldSize:     true,
+	FieldFPS:      true,
+}
+
+// UserMetadata returns the name of the user metadata field key, for use with the
+// filter constructors.
+func UserMetadata(key string) string {
+	return userMetadataPrefix + key
+}
+
+type filterOp int
+
+const (
+	opEq filterOp = iota
+	opIn
+	opRange
+	opExists
+	opAnd
+	opOr
+)
+
+// Filter is a condition on the videos to search or list. Filters are built with
+// Eq, In, Range, Gte, Lte and Exists and combined with And and Or; they are immutable and
+// can be shared between requests.
+//
+// A filter marshals to the filter JSON of the API, e.g.
+//
+//	search.And(
+//	    search.Eq(search.UserMetadata("category"), "sports"),
+//	    search.Range(search.FieldDuration, 60, 600),
+//	)
+//
+// marshals to {"duration":{"gte":60,"lte":600},"user_metadata.category":"sports"}.
+// Marshaling fails if the filter is invalid, see Validate.
+type Filter struct {
+	op       filterOp
+	field    string
+	values   []any
+	min, max *float64 // nil leaves the bound open
+	exists   bool
+	operands []*Filter
+}
+
+// Eq matches videos whose field equals value. value must be a string, a number or a bool.
+func Eq(field string, value any) *Filter {
+	return &Filter{op: opEq, field: field, values: []any{value}}
+}
+
+// In matches videos whose field equals any of values.
+func In[T any](field string, values ...T) *Filter {
+	f := &Filter{op: opIn, field: field, values: make([]any, len(values))}
+	for i, value := range values {
+		f.values[i] = value
+	}
+	return f
+}
+
+// Range matches videos whose numeric field is between min and max, inclusive.
+// Use Gte or Lte for a range with a single bound.
+func Range(field string, min, max float64) *Filter {
+	return &Filter{op: opRange, field: field, min: &min, max: &max}
+}
+
+// Gte matches videos whose numeric field is greater than or equal to min.
+func Gte(field string, min float64) *Filter {
+	return &Filter{op: opRange, field: field, min: &min}
+}
+
+// Lte matches videos whose numeric field is less than or equal to max.
+func Lte(field string, max float64) *Filter {
+	return &Filter{op: opRange, field: field, max: &max}
+}
+
+// Exists matches videos that have the field when exists is true, and videos that
+// do not have it otherwise. It is mostly useful with user metadata fields.
+func Exists(field string, exists bool) *Filter {
+	return &Filter{op: opExists, field: field, exists: exists}
+}
+
+// And matches videos matching all of filters.
+func And(filters ...*Filter) *Filter {
+	return &Filter{op: opAnd, operands: filters}
+}
+
+// Or matches videos matching any of filters.
+func Or(filters ...*Filter) *Filter {
+	return &Filter{op: opOr, operands: filters}
+}
+
+// Validate reports whether the filter can be sent to the API. It checks that every
+// field is a known system field or a user metadata field, that values have the
+// type of their field, that ranges are only used on numbers and are not empty, and
+// that And and Or have operands. The error is a validation error.
+func (f *Filter) Validate() error {
+	if err := f.validate(); err != nil {
+		return errors.NewValidationError("invalid filter: " + err.Error())
+	}
+	return nil
+}
+
+func (f *Filter) validate() error {
+	if f == nil {
+		return fmt.Errorf("nil filter")
+	}
+	switch f.op {
+	case opAnd, opOr:
+		if len(f.operands) == 0 {
+			return fmt.Errorf("%s needs at least one filter", f.op)
+		}
+		for _, operand := range f.operands {
+			if err := operand.validate(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	numeric, err := fieldKind(f.field)
+	if err != nil {
+		return err
+	}
+	switch f.op {
+	case opIn:
+		if len(f.values) == 0 {
+			return fmt.Errorf("%s: in needs at least one value", f.field)
+		}
+	case opRange:
+		if numeric != nil && !*numeric {
+			return fmt.Errorf("%s: range needs a numeric field", f.field)
+		}
+		if f.min != nil && f.max != nil && *f.min > *f.max {
+			return fmt.Errorf("%s: range minimum %g is above maximum %g", f.field, *f.min, *f.max)
+		}
+	}
+	for _, value := range f.values {
+		if err := checkValue(f.field, numeric, value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// fieldKind checks that field can be filtered on and reports whether it holds
+// numbers. The kind of user metadata fields is not known and is reported as nil.
+func fieldKind(field string) (*bool, error) {
+	if key, ok := strings.CutPrefix(field, userMetadataPrefix); ok {
+		if key == "" {
+			return nil, fmt.Errorf("empty user metadata key")
+		}
+		return nil, nil
+	}
+	switch field {
+	case FieldID, FieldFilename:
+		numeric := false
+		return &numeric, nil
+	}
+	if numericFields[field] {
+		numeric := true
+		return &numeric, nil
+	}
+	return nil, fmt.Errorf("unknown field %q; use search.UserMetadata for user metadata", field)
+}
+
+// checkValue checks that value is a scalar of the kind of its field.
+func checkValue(field string, numeric *bool, value any) error {
+	var isNumber, isString bool
+	switch reflect.ValueOf(value).Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		isNumber = true
+	case reflect.String:
+		isString = true
+	case reflect.Bool:
+	default:
+		return fmt.Errorf("%s: value %v of type %T is not a string, number or bool", field, value, value)
+	}
+	switch {
+	case numeric == nil:
+	case *numeric && !isNumber:
+		return fmt.Errorf("%s: value %v is not a number", field, value)
+	case !*numeric && !isString:
+		return fmt.Errorf("%s: value %v is not a string", field, value)
+	}
+	return nil
+}
+
+// MarshalJSON encodes the filter as the filter JSON of the API. Conditions on
+// distinct fields combined with And are merged into a single object; other
+// combinations are encoded with $and and $or.
+func (f *Filter) MarshalJSON() ([]byte, error) {
+	if err := f.Validate(); err != nil {
+		return nil, err
+	}
+	return json.Marshal(f.object())
+}
+
+// Build validates the filter and returns its filter JSON, for use as the Filter
+// of a search request.
+//
+// Example:
+//
+//	filter, err := search.And(
+//	    search.In(search.FieldID, videoIDs...),
+//	    search.Exists(search.UserMetadata("reviewed"), false),
+//	).Build()
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	results, err := client.Search.Query(ctx, &models.SearchQueryRequest{
+//	    IndexID:       indexID,
+//	    QueryText:     "goal celebration",
+//	    SearchOptions: []string{"visual"},
+//	    Filter:        filter,
+//	})
+func (f *Filter) Build() (string, error) {
+	data, err := f.MarshalJSON()
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+// object returns the JSON object of a valid filter.
+func (f *Filter) object() map[string]any {
+	switch f.op {
+	case opEq:
+		return map[string]any{f.field: f.values[0]}
+	case opIn:
+		return map[string]any{f.field: f.values}
+	case opRange:
+		bounds := map[string]float64{}
+		if f.min != nil {
+			bounds["gte"] = *f.min
+		}
+		if f.max != nil {
+			bounds["lte"] = *f.max
+		}
+		return map[string]any{f.field: bounds}
+	case opExists:
+		return map[string]any{f.field: map[string]bool{"exists": f.exists}}
+	case opOr:
+		return map[string]any{"$or": objects(f.operands)}
+	}
+
+	conditions := f.conditions()
+	merged := make(map[string]any, len(conditions))
+	for _, condition := range conditions {
+		if condition.op == opOr {
+			return map[string]any{"$and": objects(conditions)}
+		}
+		if _, ok := merged[condition.field]; ok {
+			return map[string]any{"$and": objects(conditions)}
+		}
+		merged[condition.field] = condition.object()[condition.field]
+	}
+	return merged
+}
+
+// conditions flattens nested And filters into the list of their conditions.
+func (f *Filter) conditions() []*Filter {
+	if f.op != opAnd {
+		return []*Filter{f}
+	}
+	var conditions []*Filter
+	for _, operand := range f.operands {
+		conditions = append(conditions, operand.conditions()...)
+	}
+	return conditions
+}
+
+func objects(filters []*Filter) []map[string]any {
+	list := make([]map[string]any, len(filters))
+	for i, filter := range filters {
+		list[i] = filter.object()
+	}
+	return list
+}
+
+// QueryValues encodes the filter as query parameters of the videos listing, so
+// that it can be used as models.VideoListOptions.Filter. Only Eq, In, Range, Gte
+// and Lte conditions combined with And can be encoded: equality as field=value, ranges
+// as field[gte] and field[lte], and user metadata fields as user_metadata[key].
+func (f *Filter) QueryValues() (url.Values, error) {
+	if err := f.Validate(); err != nil {
+		return nil, err
+	}
+	values := url.Values{}
+	for _, condition := range f.conditions() {
+		key := condition.field
+		if name, ok := strings.CutPrefix(key, userMetadataPrefix); ok {
+			key = "user_metadata[" + name + "]"
+		}
+		switch condition.op {
+		case opEq, opIn:
+			for _, value := range condition.values {
+				values.Add(key, fmt.Sprint(value))
+			}
+		case opRange:
+			if condition.min != nil {
+				values.Set(key+"[gte]", strconv.FormatFloat(*condition.min, 'f', -1, 64))
+			}
+			if condition.max != nil {
+				values.Set(key+"[lte]", strconv.FormatFloat(*condition.max, 'f', -1, 64))
+			}
+		default:
+			return nil, errors.NewValidationError("invalid filter: " + condition.op.String() + " cannot be used to list videos")
+		}
+	}
+	return values, nil
+}
+
+func (op filterOp) String() string {
+	switch op {
+	case opEq:
+		return "eq"
+	case opIn:
+		return "in"
+	case opRange:
+		return "range"
+	case opExists:
+		return "exists"
+	case opAnd:
+		return "and"
+	default:
+		return "or"
+	}
+}
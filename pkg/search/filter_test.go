@@ -0,0 +1,160 @@
+package search
+
+import (
+	stderrors "errors"
+	"net/url"
+	"reflect"
+	"testing"
+
+	"github.com/favourthemaster/twelvelabs-go-sdk/pkg/errors"
+)
+
+func TestValidateValueTypes(t *testing.T) {
+	tests := []struct {
+		name    string
+		filter  *Filter
+		wantErr bool
+	}{
+		{name: "string ID", filter: Eq(FieldID, "video-1")},
+		{name: "bool ID", filter: Eq(FieldID, true), wantErr: true},
+		{name: "number ID", filter: In[any](FieldID, "video-1", 2), wantErr: true},
+		{name: "bool filename", filter: Eq(FieldFilename, false), wantErr: true},
+		{name: "number duration", filter: Eq(FieldDuration, 60)},
+		{name: "string duration", filter: Eq(FieldDuration, "60"), wantErr: true},
+		{name: "bool user metadata", filter: Eq(UserMetadata("reviewed"), true)},
+		{name: "inverted range", filter: Range(FieldDuration, 600, 60), wantErr: true},
+		{name: "range on a string field", filter: Gte(FieldFilename, 1), wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.filter.Validate()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Validate() = %v, want error %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestMarshalJSON(t *testing.T) {
+	tests := []struct {
+		name   string
+		filter *Filter
+		want   string
+	}{
+		{
+			name: "distinct fields are merged",
+			filter: And(
+				Eq(UserMetadata("category"), "sports"),
+				Range(FieldDuration, 60, 600),
+				In(FieldID, "video-1", "video-2"),
+			),
+			want: `{"duration":{"gte":60,"lte":600},"id":["video-1","video-2"],"user_metadata.category":"sports"}`,
+		},
+		{
+			name:   "nested and is flattened",
+			filter: And(And(Eq(FieldFilename, "intro.mp4")), Eq(FieldFPS, 30)),
+			want:   `{"filename":"intro.mp4","fps":30}`,
+		},
+		{
+			name:   "zero bound is kept",
+			filter: Gte(FieldDuration, 0),
+			want:   `{"duration":{"gte":0}}`,
+		},
+		{
+			name:   "upper bound only",
+			filter: Lte(FieldWidth, 1920),
+			want:   `{"width":{"lte":1920}}`,
+		},
+		{
+			name:   "duplicate fields use $and",
+			filter: And(Gte(FieldDuration, 60), Lte(FieldDuration, 600)),
+			want:   `{"$and":[{"duration":{"gte":60}},{"duration":{"lte":600}}]}`,
+		},
+		{
+			name:   "or",
+			filter: Or(Eq(FieldWidth, 1920), Exists(UserMetadata("hd"), true)),
+			want:   `{"$or":[{"width":1920},{"user_metadata.hd":{"exists":true}}]}`,
+		},
+		{
+			name:   "or inside and uses $and",
+			filter: And(Eq(FieldID, "video-1"), Or(Eq(FieldHeight, 1080), Eq(FieldHeight, 720))),
+			want:   `{"$and":[{"id":"video-1"},{"$or":[{"height":1080},{"height":720}]}]}`,
+		},
+		{
+			name:   "exists false",
+			filter: Exists(UserMetadata("reviewed"), false),
+			want:   `{"user_metadata.reviewed":{"exists":false}}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.filter.Build()
+			if err != nil {
+				t.Fatalf("Build: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("Build() = %s\nwant %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestQueryValues(t *testing.T) {
+	tests := []struct {
+		name   string
+		filter *Filter
+		want   url.Values
+	}{
+		{
+			name:   "user metadata",
+			filter: Eq(UserMetadata("category"), "sports"),
+			want:   url.Values{"user_metadata[category]": {"sports"}},
+		},
+		{
+			name:   "in",
+			filter: In(FieldID, "video-1", "video-2"),
+			want:   url.Values{"id": {"video-1", "video-2"}},
+		},
+		{
+			name: "ranges",
+			filter: And(
+				Gte(FieldDuration, 60),
+				Lte(FieldSize, 1e6),
+				Range(UserMetadata("rating"), 0, 4.5),
+			),
+			want: url.Values{
+				"duration[gte]":              {"60"},
+				"size[lte]":                  {"1000000"},
+				"user_metadata[rating][gte]": {"0"},
+				"user_metadata[rating][lte]": {"4.5"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.filter.QueryValues()
+			if err != nil {
+				t.Fatalf("QueryValues: %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("QueryValues() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestQueryValuesRejectsOrAndExists(t *testing.T) {
+	filters := map[string]*Filter{
+		"or":            Or(Eq(FieldWidth, 1920), Eq(FieldWidth, 1280)),
+		"exists":        Exists(UserMetadata("hd"), true),
+		"exists in and": And(Eq(FieldID, "video-1"), Exists(UserMetadata("hd"), false)),
+	}
+	for name, filter := range filters {
+		t.Run(name, func(t *testing.T) {
+			values, err := filter.QueryValues()
+			if !stderrors.Is(err, errors.ErrValidation) {
+				t.Errorf("QueryValues() = %v, %v, want a validation error", values, err)
+			}
+		})
+	}
+}
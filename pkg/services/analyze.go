@@ -4,11 +4,12 @@ import (
 	"bufio"
 	"context"
 	"encoding/json"
-	"fmt"
 	"io"
+	"log/slog"
 
 	"github.com/favourthemaster/twelvelabs-go-sdk/pkg/errors"
 	"github.com/favourthemaster/twelvelabs-go-sdk/pkg/models"
+	"github.com/favourthemaster/twelvelabs-go-sdk/pkg/telemetry"
 )
 
 type AnalyzeService struct {
@@ -17,6 +18,7 @@ type AnalyzeService struct {
 
 // Analyze performs video analysis with the given request parameters
 func (s *AnalyzeService) Analyze(ctx context.Context, reqBody *models.AnalyzeRequest) (*models.AnalyzeResponse, error) {
+	ctx = telemetry.WithVideoID(ctx, reqBody.VideoID)
 	req, err := s.Client.NewRequest(ctx, "POST", "/analyze", reqBody)
 	if err != nil {
 		return nil, errors.NewRequestError("failed to create analyze request: " + err.Error())
@@ -25,7 +27,10 @@ func (s *AnalyzeService) Analyze(ctx context.Context, reqBody *models.AnalyzeReq
 	var response models.AnalyzeResponse
 	_, err = s.Client.Do(req, &response)
 	if err != nil {
-		return nil, errors.NewServiceError("Analyze", "analyze request failed: "+err.Error())
+		return nil, errors.WrapServiceError("Analyze", "analyze request failed", err)
+	}
+	if response.Usage != nil {
+		s.Client.Telemetry().RecordOutputTokens(ctx, "analyze", response.Usage.OutputTokens)
 	}
 
 	return &response, nil
@@ -36,6 +41,7 @@ func (s *AnalyzeService) AnalyzeStream(ctx context.Context, reqBody *models.Anal
 	// Set stream to true for streaming requests
 	streamReq := *reqBody
 	streamReq.Stream = true
+	ctx = telemetry.WithVideoID(ctx, reqBody.VideoID)
 
 	// Handle JSON request for video_id or video_url
 	req, err := s.Client.NewRequest(ctx, "POST", "/analyze", &streamReq)
@@ -45,16 +51,21 @@ func (s *AnalyzeService) AnalyzeStream(ctx context.Context, reqBody *models.Anal
 
 	resp, err := s.Client.DoRaw(req)
 	if err != nil {
-		return errors.NewServiceError("Analyze", "analyze stream request failed: "+err.Error())
+		return errors.WrapServiceError("Analyze", "analyze stream request failed", err)
 	}
 	defer func(Body io.ReadCloser) {
 		err := Body.Close()
 		if err != nil {
-			fmt.Printf("failed to close response body: %v\n", err)
+			s.Client.Logger().Warn("failed to close response body", slog.Any("error", err))
 		}
 	}(resp.Body)
 
-	return s.processStreamResponse(resp.Body, callback)
+	return s.processStreamResponse(resp.Body, func(event *models.AnalyzeStreamResponse) error {
+		if event.EventType == "stream_end" && event.Metadata != nil && event.Metadata.Usage != nil {
+			s.Client.Telemetry().RecordOutputTokens(ctx, "analyze", event.Metadata.Usage.OutputTokens)
+		}
+		return callback(event)
+	})
 }
 
 // processStreamResponse processes the streaming response
@@ -76,7 +87,7 @@ func (s *AnalyzeService) processStreamResponse(body io.Reader, callback func(*mo
 		}
 
 		if err := callback(&streamResp); err != nil {
-			return errors.NewServiceError("Analyze", "callback error: "+err.Error())
+			return errors.WrapServiceError("Analyze", "callback error", err)
 		}
 
 		// Stop processing if we hit a stream_end event
@@ -86,13 +97,14 @@ func (s *AnalyzeService) processStreamResponse(body io.Reader, callback func(*mo
 	}
 
 	if err := scanner.Err(); err != nil {
-		return errors.NewServiceError("Analyze", "error reading stream response: "+err.Error())
+		return errors.WrapServiceError("Analyze", "error reading stream response", err)
 	}
 
 	return nil
 }
 
 func (s *AnalyzeService) GenerateGist(ctx context.Context, reqBody *models.GenerateGistRequest) (*models.GenerateGistResponse, error) {
+	ctx = telemetry.WithVideoID(ctx, reqBody.VideoID)
 	req, err := s.Client.NewRequest(ctx, "POST", "/gist", reqBody)
 	if err != nil {
 		return nil, errors.NewRequestError("failed to create gist request: " + err.Error())
@@ -101,13 +113,17 @@ func (s *AnalyzeService) GenerateGist(ctx context.Context, reqBody *models.Gener
 	var response models.GenerateGistResponse
 	_, err = s.Client.Do(req, &response)
 	if err != nil {
-		return nil, errors.NewServiceError("Analyze", "gist request failed: "+err.Error())
+		return nil, errors.WrapServiceError("Analyze", "gist request failed", err)
+	}
+	if response.Usage != nil {
+		s.Client.Telemetry().RecordOutputTokens(ctx, "gist", response.Usage.OutputTokens)
 	}
 
 	return &response, nil
 }
 
 func (s *AnalyzeService) GenerateSummary(ctx context.Context, reqBody *models.GenerateSummaryRequest) (*models.GenerateSummaryResponse, error) {
+	ctx = telemetry.WithVideoID(ctx, reqBody.VideoID)
 	req, err := s.Client.NewRequest(ctx, "POST", "/summarize", reqBody)
 	if err != nil {
 		return nil, errors.NewRequestError("failed to create summarize request: " + err.Error())
@@ -116,7 +132,10 @@ func (s *AnalyzeService) GenerateSummary(ctx context.Context, reqBody *models.Ge
 	var response models.GenerateSummaryResponse
 	_, err = s.Client.Do(req, &response)
 	if err != nil {
-		return nil, errors.NewServiceError("Analyze", "summarize request failed: "+err.Error())
+		return nil, errors.WrapServiceError("Analyze", "summarize request failed", err)
+	}
+	if response.Usage != nil {
+		s.Client.Telemetry().RecordOutputTokens(ctx, "summarize", response.Usage.OutputTokens)
 	}
 
 	return &response, nil
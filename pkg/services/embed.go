@@ -1,22 +1,24 @@
 package services
 
 import (
-	"bytes"
 	"context"
 	"fmt"
-	"io"
-	"mime/multipart"
+	"log/slog"
 	"net/http"
-	"os"
 	"time"
 
 	"github.com/favourthemaster/twelvelabs-go-sdk/pkg/models"
+	"github.com/favourthemaster/twelvelabs-go-sdk/pkg/poll"
+	"github.com/favourthemaster/twelvelabs-go-sdk/pkg/telemetry"
 )
 
 type ClientInterface interface {
 	NewRequest(ctx context.Context, method, path string, body interface{}) (*http.Request, error)
 	Do(req *http.Request, v interface{}) (*http.Response, error)
 	DoRaw(req *http.Request) (*http.Response, error)
+	DoExternal(req *http.Request) (*http.Response, error)
+	Logger() *slog.Logger
+	Telemetry() *telemetry.Instrumentation
 }
 
 type EmbedService struct {
@@ -24,139 +26,84 @@ type EmbedService struct {
 }
 
 func (s *EmbedService) Create(ctx context.Context, reqBody *models.EmbedRequest) (*models.EmbedResponse, error) {
-	var b bytes.Buffer
-	w := multipart.NewWriter(&b)
-
-	// Add model_name field
-	if err := w.WriteField("model_name", reqBody.ModelName); err != nil {
-		return nil, fmt.Errorf("failed to write model_name field: %w", err)
+	w := newMultipartForm()
+	w.OnProgress(reqBody.OnProgress)
+	if err := encodeForm(w, reqBody); err != nil {
+		return nil, err
 	}
 
-	// Add text field if provided
-	if reqBody.Text != "" {
-		if err := w.WriteField("text", reqBody.Text); err != nil {
-			return nil, fmt.Errorf("failed to write text field: %w", err)
-		}
+	path := "/embed"
+	isVideo := reqBody.VideoFile != "" || reqBody.VideoSource != nil || reqBody.VideoURL != ""
+	if isVideo {
+		path = "/embed/tasks"
 	}
 
-	// Add image_url field if provided
-	if reqBody.ImageURL != "" {
-		if err := w.WriteField("image_url", reqBody.ImageURL); err != nil {
-			return nil, fmt.Errorf("failed to write image_url field: %w", err)
-		}
+	req, err := w.NewRequest(ctx, s.Client, "POST", path)
+	if err != nil {
+		return nil, err
 	}
 
-	// Add image_file field if provided
-	if reqBody.ImageFile != "" {
-		file, err := os.Open(reqBody.ImageFile)
-		if err != nil {
-			return nil, fmt.Errorf("failed to open image file: %w", err)
-		}
-		defer func(file *os.File) {
-			err := file.Close()
-			if err != nil {
-				fmt.Printf("failed to close image file: %v\n", err)
-			}
-		}(file)
-
-		part, err := w.CreateFormFile("image_file", reqBody.ImageFile)
+	if isVideo {
+		var data map[string]interface{}
+		_, err = s.Client.Do(req, &data)
 		if err != nil {
-			return nil, fmt.Errorf("failed to create form file: %w", err)
-		}
-
-		if _, err = io.Copy(part, file); err != nil {
-			return nil, fmt.Errorf("failed to copy file content: %w", err)
+			return nil, err
 		}
-	}
-
-	// Add video fields if provided
-	if reqBody.VideoURL != "" {
-		if err := w.WriteField("video_url", reqBody.VideoURL); err != nil {
-			return nil, fmt.Errorf("failed to write video_url field: %w", err)
+		embedID, ok := data["_id"].(string)
+		if !ok {
+			return nil, fmt.Errorf("embed task response is missing the task ID")
 		}
-	}
-
-	if reqBody.VideoFile != "" {
-		file, err := os.Open(reqBody.VideoFile)
-		if err != nil {
-			return nil, fmt.Errorf("failed to open video file: %w", err)
-		}
-		defer func(file *os.File) {
-			err := file.Close()
-			if err != nil {
-				fmt.Printf("failed to close video file: %v\n", err)
-			}
-		}(file)
-
-		part, err := w.CreateFormFile("video_file", reqBody.VideoFile)
+		embedResponse, err := s.WaitForEmbedTask(ctx, embedID, 10*time.Second, nil)
 		if err != nil {
-			return nil, fmt.Errorf("failed to create form file: %w", err)
-		}
-
-		if _, err = io.Copy(part, file); err != nil {
-			return nil, fmt.Errorf("failed to copy file content: %w", err)
+			return nil, fmt.Errorf("failed to wait for embed task: %w", err)
 		}
+		return embedResponse, nil
 	}
 
-	// Add audio_url field if provided
-	if reqBody.AudioURL != "" {
-		if err := w.WriteField("audio_url", reqBody.AudioURL); err != nil {
-			return nil, fmt.Errorf("failed to write audio_url field: %w", err)
-		}
+	var embedResponse models.EmbedResponse
+	_, err = s.Client.Do(req, &embedResponse)
+	if err != nil {
+		return nil, err
 	}
 
-	if reqBody.AudioFile != "" {
-		file, err := os.Open(reqBody.AudioFile)
-		if err != nil {
-			return nil, fmt.Errorf("failed to open audio file: %w", err)
-		}
-		defer func(file *os.File) {
-			err := file.Close()
-			if err != nil {
-				fmt.Printf("failed to close audio file: %v\n", err)
-			}
-		}(file)
-
-		part, err := w.CreateFormFile("audio_file", reqBody.AudioFile)
-		if err != nil {
-			return nil, fmt.Errorf("failed to create form file: %w", err)
-		}
+	return &embedResponse, nil
+}
 
-		if _, err = io.Copy(part, file); err != nil {
-			return nil, fmt.Errorf("failed to copy file content: %w", err)
+// WaitForEmbedTask polls the embedding task until it is ready, failed or in error,
+// starting at interval and backing off while its status does not change, and
+// returns its embeddings. callback receives the status after every poll.
+func (s *EmbedService) WaitForEmbedTask(ctx context.Context, taskID string, interval time.Duration, callback func(status models.EmbedTaskStatus)) (_ *models.EmbedResponse, err error) {
+	ctx, span := s.Client.Telemetry().StartSpan(ctx, "twelvelabs.embed.wait_for_task", telemetry.AttrTaskID.String(taskID))
+	defer func() { telemetry.EndSpan(span, err) }()
+
+	poller := &poll.Poller[*models.EmbedTaskStatus]{
+		Interval: interval,
+		Done: func(status *models.EmbedTaskStatus) bool {
+			return status.Status == "ready" || status.Status == "failed" || status.Status == "error"
+		},
+		State:  func(status *models.EmbedTaskStatus) string { return status.Status },
+		Logger: s.Client.Logger().With(slog.String("task_id", taskID)),
+	}
+	if callback != nil {
+		poller.OnPoll = func(status *models.EmbedTaskStatus) error {
+			callback(*status)
+			return nil
 		}
 	}
-
-	err := w.Close()
+	status, err := poller.Poll(ctx, func(ctx context.Context) (*models.EmbedTaskStatus, error) {
+		return s.RetrieveStatus(ctx, taskID)
+	})
 	if err != nil {
 		return nil, err
 	}
-
-	path := "/embed"
-	if reqBody.VideoFile != "" || reqBody.VideoURL != "" {
-		path = "/embed/tasks"
+	if status.Status != "ready" {
+		return nil, fmt.Errorf("embed task failed with status: %s", status.Status)
 	}
 
-	req, err := s.Client.NewRequest(ctx, "POST", path, &b)
+	req, err := s.Client.NewRequest(ctx, "GET", fmt.Sprintf("/embed/tasks/%s", taskID), nil)
 	if err != nil {
 		return nil, err
 	}
-	req.Header.Set("Content-Type", w.FormDataContentType())
-
-	if reqBody.VideoFile != "" || reqBody.VideoURL != "" {
-		var data map[string]interface{}
-		_, err = s.Client.Do(req, &data)
-		if err != nil {
-			return nil, err
-		}
-		if embedID, ok := data["_id"].(string); ok {
-			embedResponse, err := s.WaitForEmbedTask(ctx, embedID, 10*time.Second, nil)
-			if err != nil {
-				return nil, fmt.Errorf("failed to wait for embed task: %w", err)
-			}
-			return embedResponse, nil
-		}
-	}
 
 	var embedResponse models.EmbedResponse
 	_, err = s.Client.Do(req, &embedResponse)
@@ -167,58 +114,18 @@ func (s *EmbedService) Create(ctx context.Context, reqBody *models.EmbedRequest)
 	return &embedResponse, nil
 }
 
-func (s *EmbedService) WaitForEmbedTask(ctx context.Context, taskID string, interval time.Duration, callback func(status models.EmbedTaskStatus)) (*models.EmbedResponse, error) {
-	for {
-		// Check for context cancellation
-		select {
-		case <-ctx.Done():
-			return nil, ctx.Err()
-		default:
-		}
-
-		req, err := s.Client.NewRequest(ctx, "GET", fmt.Sprintf("/embed/tasks/%s/status", taskID), nil)
-		if err != nil {
-			return nil, err
-		}
-
-		var status models.EmbedTaskStatus
-		resp, err := s.Client.Do(req, &status)
-		if err != nil {
-			return nil, err
-		}
-
-		if resp.StatusCode != http.StatusOK {
-			return nil, fmt.Errorf("failed to get task status: %s", resp.Status)
-		}
-
-		if status.Status == "ready" {
-			embedReq, err := s.Client.NewRequest(ctx, "GET", fmt.Sprintf("/embed/tasks/%s", taskID), nil)
-			if err != nil {
-				return nil, err
-			}
-
-			var embedResponse models.EmbedResponse
-			_, err = s.Client.Do(embedReq, &embedResponse)
-			if err != nil {
-				return nil, err
-			}
-
-			return &embedResponse, nil
-		} else if status.Status == "failed" {
-			return nil, fmt.Errorf("embed task failed with status: %s", status.Status)
-		}
-
-		if callback != nil {
-			callback(status)
-		}
-
-		func(Body io.ReadCloser) {
-			err := Body.Close()
-			if err != nil {
-				fmt.Printf("failed to close response body: %v\n", err)
-			}
-		}(resp.Body)
+// RetrieveStatus gets the current status of an embedding task.
+func (s *EmbedService) RetrieveStatus(ctx context.Context, taskID string) (*models.EmbedTaskStatus, error) {
+	req, err := s.Client.NewRequest(ctx, "GET", fmt.Sprintf("/embed/tasks/%s/status", taskID), nil)
+	if err != nil {
+		return nil, err
+	}
 
-		time.Sleep(interval)
+	var status models.EmbedTaskStatus
+	_, err = s.Client.Do(req, &status)
+	if err != nil {
+		return nil, err
 	}
+
+	return &status, nil
 }
@@ -0,0 +1,170 @@
+package services
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/favourthemaster/twelvelabs-go-sdk/pkg/models"
+)
+
+var mediaSourceType = reflect.TypeOf((*models.MediaSource)(nil))
+
+// formField describes how a struct field is written to a multipart form.
+type formField struct {
+	index     int
+	name      string
+	omitEmpty bool
+	// file marks a string field holding a local path that is uploaded as a file part.
+	file bool
+	// media names the file part that takes precedence over the field when a
+	// media source is set for it.
+	media string
+}
+
+// formFields returns the form fields of a request struct type. The field name and
+// options come from the "form" tag and fall back to the "json" tag:
+//
+//	form:"video_file,file"   a local path uploaded as a file part
+//	form:"video_file"        on a *models.MediaSource, the media uploaded as that part
+//	form:"video_url,media=video_file"
+//	                         left out when a media source is set for video_file
+//	json:"name,omitempty"    a plain field, omitted when it holds its zero value
+//
+// Fields tagged "-", and fields without a name, are skipped.
+func formFields(t reflect.Type) []formField {
+	var fields []formField
+	for i := range t.NumField() {
+		sf := t.Field(i)
+		if !sf.IsExported() {
+			continue
+		}
+		tag, ok := sf.Tag.Lookup("form")
+		if !ok {
+			tag = sf.Tag.Get("json")
+		}
+		name, opts, _ := strings.Cut(tag, ",")
+		if name == "" || name == "-" {
+			continue
+		}
+		field := formField{index: i, name: name}
+		for _, opt := range strings.Split(opts, ",") {
+			switch opt {
+			case "omitempty":
+				field.omitEmpty = true
+			case "file":
+				field.file = true
+			default:
+				if part, ok := strings.CutPrefix(opt, "media="); ok {
+					field.media = part
+				}
+			}
+		}
+		fields = append(fields, field)
+	}
+	return fields
+}
+
+// encodeForm writes every tagged field of the request struct v to the form, in
+// declaration order. Strings and numbers are written as text, slices of scalars as
+// one field per element, and maps and structs as JSON. A media source takes
+// precedence over a file path field of the same name and over the fields naming
+// its part with the media option.
+func encodeForm(w *multipartForm, v any) error {
+	rv := reflect.Indirect(reflect.ValueOf(v))
+	if rv.Kind() != reflect.Struct {
+		return fmt.Errorf("cannot encode %T as a form", v)
+	}
+	fields := formFields(rv.Type())
+
+	sources := make(map[string]bool)
+	for _, field := range fields {
+		fv := rv.Field(field.index)
+		if fv.Type() == mediaSourceType && !fv.IsNil() {
+			sources[field.name] = true
+		}
+	}
+
+	for _, field := range fields {
+		fv := rv.Field(field.index)
+		switch {
+		case fv.Type() == mediaSourceType:
+			if fv.IsNil() {
+				continue
+			}
+			if err := w.AddMedia(field.name, fv.Interface().(*models.MediaSource)); err != nil {
+				return fmt.Errorf("failed to add %s: %w", field.name, err)
+			}
+		case field.file:
+			if fv.String() == "" || sources[field.name] {
+				continue
+			}
+			if err := w.AddMedia(field.name, models.MediaFromPath(fv.String())); err != nil {
+				return fmt.Errorf("failed to add %s: %w", field.name, err)
+			}
+		default:
+			if (field.omitEmpty && fv.IsZero()) || sources[field.media] {
+				continue
+			}
+			if err := writeFormValue(w, field.name, fv); err != nil {
+				return fmt.Errorf("failed to write %s field: %w", field.name, err)
+			}
+		}
+	}
+	return nil
+}
+
+// writeFormValue writes a single struct field value under name.
+func writeFormValue(w *multipartForm, name string, v reflect.Value) error {
+	if text, ok := formText(v); ok {
+		return w.WriteField(name, text)
+	}
+	switch v.Kind() {
+	case reflect.Func, reflect.Chan:
+		return nil
+	case reflect.Slice, reflect.Array:
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			break
+		}
+		if _, ok := formText(reflect.Zero(v.Type().Elem())); ok {
+			for i := range v.Len() {
+				text, _ := formText(v.Index(i))
+				if err := w.WriteField(name, text); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	case reflect.Pointer:
+		if v.IsNil() {
+			return nil
+		}
+		if text, ok := formText(v.Elem()); ok {
+			return w.WriteField(name, text)
+		}
+	}
+	data, err := json.Marshal(v.Interface())
+	if err != nil {
+		return err
+	}
+	return w.WriteField(name, string(data))
+}
+
+// formText formats scalar values as form text.
+func formText(v reflect.Value) (string, bool) {
+	switch v.Kind() {
+	case reflect.String:
+		return v.String(), true
+	case reflect.Bool:
+		return strconv.FormatBool(v.Bool()), true
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(v.Int(), 10), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(v.Uint(), 10), true
+	case reflect.Float32, reflect.Float64:
+		return strconv.FormatFloat(v.Float(), 'f', -1, v.Type().Bits()), true
+	}
+	return "", false
+}
@@ -0,0 +1,276 @@
+package services
+
+import (
+	stderrors "errors"
+	"io"
+	"mime"
+	"mime/multipart"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/favourthemaster/twelvelabs-go-sdk/pkg/errors"
+	"github.com/favourthemaster/twelvelabs-go-sdk/pkg/models"
+)
+
+// formPart is a file part of a parsed multipart form.
+type formPart struct {
+	filename string
+	content  string
+}
+
+// parsedForm holds the plain fields and file parts of a multipart form.
+type parsedForm struct {
+	fields map[string][]string
+	files  map[string]formPart
+}
+
+// parseForm reads a form body with mime/multipart.
+func parseForm(t *testing.T, contentType string, body io.Reader) parsedForm {
+	t.Helper()
+	_, params, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		t.Fatalf("parse content type %q: %v", contentType, err)
+	}
+	form := parsedForm{fields: map[string][]string{}, files: map[string]formPart{}}
+	reader := multipart.NewReader(body, params["boundary"])
+	for {
+		part, err := reader.NextPart()
+		if stderrors.Is(err, io.EOF) {
+			return form
+		}
+		if err != nil {
+			t.Fatalf("read part: %v", err)
+		}
+		content, err := io.ReadAll(part)
+		if err != nil {
+			t.Fatalf("read part %s: %v", part.FormName(), err)
+		}
+		if part.FileName() != "" {
+			form.files[part.FormName()] = formPart{filename: part.FileName(), content: string(content)}
+			continue
+		}
+		form.fields[part.FormName()] = append(form.fields[part.FormName()], string(content))
+	}
+}
+
+// encodeAndParse encodes request with encodeForm and parses the resulting body.
+func encodeAndParse(t *testing.T, request any) parsedForm {
+	t.Helper()
+	w := newMultipartForm()
+	if err := encodeForm(w, request); err != nil {
+		t.Fatalf("encodeForm: %v", err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatalf("close form: %v", err)
+	}
+	body, err := w.Open()
+	if err != nil {
+		t.Fatalf("open form: %v", err)
+	}
+	defer body.Close()
+	return parseForm(t, w.FormDataContentType(), body)
+}
+
+func writeTempFile(t *testing.T, name, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), name)
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestEncodeForm(t *testing.T) {
+	videoPath := writeTempFile(t, "clip.mp4", "video bytes")
+	audioPath := writeTempFile(t, "voice.mp3", "audio bytes")
+	missing := filepath.Join(t.TempDir(), "missing.mp4")
+
+	tests := []struct {
+		name       string
+		request    any
+		wantFields map[string][]string
+		wantFiles  map[string]formPart
+	}{
+		{
+			name: "task with every field",
+			request: &models.TasksCreateRequest{
+				IndexID:             "index-1",
+				VideoFile:           videoPath,
+				VideoStartOffsetSec: 5,
+				VideoEndOffsetSec:   60,
+				VideoClipLength:     6,
+				VideoEmbeddingScope: []string{"clip", "video"},
+				EnableVideoStream:   true,
+				UserMetadata:        map[string]string{"title": "Intro"},
+				OnProgress:          func(models.UploadProgress) {},
+			},
+			wantFields: map[string][]string{
+				"index_id":               {"index-1"},
+				"video_start_offset_sec": {"5"},
+				"video_end_offset_sec":   {"60"},
+				"video_clip_length":      {"6"},
+				"video_embedding_scope":  {"clip", "video"},
+				"enable_video_stream":    {"true"},
+				"user_metadata":          {`{"title":"Intro"}`},
+			},
+			wantFiles: map[string]formPart{
+				"video_file": {filename: "clip.mp4", content: "video bytes"},
+			},
+		},
+		{
+			name: "task from URL leaves zero values out",
+			request: &models.TasksCreateRequest{
+				IndexID:  "index-1",
+				VideoURL: "https://example.com/video.mp4",
+			},
+			wantFields: map[string][]string{
+				"index_id":  {"index-1"},
+				"video_url": {"https://example.com/video.mp4"},
+			},
+			wantFiles: map[string]formPart{},
+		},
+		{
+			name: "task media source takes precedence over path and URL",
+			request: &models.TasksCreateRequest{
+				IndexID:     "index-1",
+				VideoFile:   missing,
+				VideoURL:    "https://example.com/video.mp4",
+				VideoSource: models.MediaFromBytes([]byte("in memory"), "memory.mp4"),
+			},
+			wantFields: map[string][]string{
+				"index_id": {"index-1"},
+			},
+			wantFiles: map[string]formPart{
+				"video_file": {filename: "memory.mp4", content: "in memory"},
+			},
+		},
+		{
+			name: "embed with every field",
+			request: &models.EmbedRequest{
+				ModelName:    "Marengo-retrieval-2.7",
+				VideoID:      "video-1",
+				Text:         "a cat",
+				TextTruncate: "end",
+				ImageURL:     "https://example.com/cat.jpg",
+				AudioFile:    audioPath,
+				VideoSource:  models.MediaFromReader(strings.NewReader("streamed"), "stream.mp4", 8),
+			},
+			wantFields: map[string][]string{
+				"model_name":    {"Marengo-retrieval-2.7"},
+				"video_id":      {"video-1"},
+				"text":          {"a cat"},
+				"text_truncate": {"end"},
+				"image_url":     {"https://example.com/cat.jpg"},
+			},
+			wantFiles: map[string]formPart{
+				"audio_file": {filename: "voice.mp3", content: "audio bytes"},
+				"video_file": {filename: "stream.mp4", content: "streamed"},
+			},
+		},
+		{
+			name: "embed media sources take precedence over paths and URLs",
+			request: &models.EmbedRequest{
+				ModelName:   "Marengo-retrieval-2.7",
+				ImageURL:    "https://example.com/cat.jpg",
+				ImageSource: models.MediaFromBytes([]byte("png"), "cat.png"),
+				AudioFile:   missing,
+				AudioSource: &models.MediaSource{Path: audioPath, Filename: "renamed.mp3"},
+			},
+			wantFields: map[string][]string{
+				"model_name": {"Marengo-retrieval-2.7"},
+			},
+			wantFiles: map[string]formPart{
+				"image_file": {filename: "cat.png", content: "png"},
+				"audio_file": {filename: "renamed.mp3", content: "audio bytes"},
+			},
+		},
+		{
+			name: "search with every field",
+			request: &models.SearchRequest{
+				IndexID:               "index-1",
+				QueryText:             "goal",
+				QueryMediaType:        "image",
+				QueryMediaFile:        missing,
+				QueryMediaURL:         "https://example.com/query.jpg",
+				QueryMediaSource:      models.MediaFromBytes([]byte("query"), "query.jpg"),
+				ConversationOption:    "semantic",
+				Filter:                `{"duration":{"gte":60}}`,
+				SearchOptions:         []string{"visual", "audio"},
+				Threshold:             "high",
+				SortOption:            "score",
+				AdjustConfidenceLevel: 0.5,
+				IncludeClips:          true,
+				GroupBy:               models.SearchGroupByVideo,
+				Operator:              models.SearchOperatorAnd,
+				TranscriptionOptions:  []string{"lexical", "semantic"},
+				PageLimit:             20,
+				PageToken:             "token-1",
+			},
+			wantFields: map[string][]string{
+				"index_id":                {"index-1"},
+				"query_text":              {"goal"},
+				"query_media_type":        {"image"},
+				"conversation_option":     {"semantic"},
+				"filter":                  {`{"duration":{"gte":60}}`},
+				"search_options":          {"visual", "audio"},
+				"threshold":               {"high"},
+				"sort_option":             {"score"},
+				"adjust_confidence_level": {"0.5"},
+				"include_clips":           {"true"},
+				"group_by":                {"video"},
+				"operator":                {"and"},
+				"transcription_options":   {"lexical", "semantic"},
+				"page_limit":              {"20"},
+				"page_token":              {"token-1"},
+			},
+			wantFiles: map[string]formPart{
+				"query_media_file": {filename: "query.jpg", content: "query"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			form := encodeAndParse(t, tt.request)
+			if !reflect.DeepEqual(form.fields, tt.wantFields) {
+				t.Errorf("fields = %v, want %v", form.fields, tt.wantFields)
+			}
+			if !reflect.DeepEqual(form.files, tt.wantFiles) {
+				t.Errorf("files = %v, want %v", form.files, tt.wantFiles)
+			}
+		})
+	}
+}
+
+func TestEncodeFormRejectsNonStruct(t *testing.T) {
+	if err := encodeForm(newMultipartForm(), "not a struct"); err == nil {
+		t.Fatal("encodeForm accepted a string")
+	}
+}
+
+func TestMediaReaderWithoutSize(t *testing.T) {
+	t.Run("seekable reader is measured", func(t *testing.T) {
+		form := encodeAndParse(t, &models.TasksCreateRequest{
+			IndexID:     "index-1",
+			VideoSource: &models.MediaSource{Reader: strings.NewReader("seekable content"), Filename: "clip.mp4"},
+		})
+		want := formPart{filename: "clip.mp4", content: "seekable content"}
+		if got := form.files["video_file"]; got != want {
+			t.Errorf("video_file = %v, want %v", got, want)
+		}
+	})
+
+	t.Run("non-seekable reader is rejected", func(t *testing.T) {
+		request := &models.TasksCreateRequest{
+			IndexID:     "index-1",
+			VideoSource: &models.MediaSource{Reader: io.MultiReader(strings.NewReader("content")), Filename: "clip.mp4"},
+		}
+		err := encodeForm(newMultipartForm(), request)
+		if !stderrors.Is(err, errors.ErrValidation) {
+			t.Fatalf("encodeForm error = %v, want a validation error", err)
+		}
+	})
+}
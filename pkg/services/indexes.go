@@ -3,42 +3,70 @@ package services
 import (
 	"context"
 	"fmt"
+	"iter"
+	"net/url"
+	"strconv"
 
 	"github.com/favourthemaster/twelvelabs-go-sdk/pkg/models"
+	"github.com/favourthemaster/twelvelabs-go-sdk/pkg/pagination"
 )
 
 type IndexesService struct {
 	Client ClientInterface
 }
 
-func (s *IndexesService) List(ctx context.Context, filters map[string]string) ([]models.Index, error) {
-	queryParams := ""
-	for key, value := range filters {
-		if queryParams != "" {
-			queryParams += "&"
-		}
-		queryParams += fmt.Sprintf("%s=%s", key, value)
-	}
-
-	url := "/indexes"
-	if queryParams != "" {
-		url += "?" + queryParams
-	}
-
-	req, err := s.Client.NewRequest(ctx, "GET", url, nil)
+// List retrieves a single page of indexes, the first unless opts.Page is set.
+func (s *IndexesService) List(ctx context.Context, opts *models.IndexListOptions) ([]models.Index, error) {
+	page, err := s.ListPage(ctx, opts)
 	if err != nil {
 		return nil, err
 	}
+	return page.Items, nil
+}
 
-	var response struct {
-		Data []models.Index `json:"data"`
+// ListPage retrieves a single page of indexes, the first unless opts.Page is set.
+// The page can fetch its neighbours with the same filters.
+func (s *IndexesService) ListPage(ctx context.Context, opts *models.IndexListOptions) (*pagination.Page[models.Index], error) {
+	var base models.IndexListOptions
+	if opts != nil {
+		base = *opts
 	}
-	_, err = s.Client.Do(req, &response)
-	if err != nil {
-		return nil, err
+	var fetch pagination.FetchFunc[models.Index]
+	fetch = func(ctx context.Context, cursor pagination.Cursor) (*pagination.Page[models.Index], error) {
+		pageOpts := base
+		pageOpts.Page = cursor.Page
+		req, err := s.Client.NewRequest(ctx, "GET", withQuery("/indexes", pageOpts.Values().Encode()), nil)
+		if err != nil {
+			return nil, err
+		}
+
+		var response models.ListResponse[models.Index]
+		_, err = s.Client.Do(req, &response)
+		if err != nil {
+			return nil, err
+		}
+
+		return pagination.New(response.Data, response.PageInfo, cursor, fetch), nil
 	}
+	return fetch(ctx, pagination.Cursor{Page: base.Page})
+}
 
-	return response.Data, nil
+// Pager returns a pager over the indexes matching opts, starting at opts.Page.
+func (s *IndexesService) Pager(opts *models.IndexListOptions) *pagination.Pager[models.Index] {
+	return pagination.NewPager(func(ctx context.Context) (*pagination.Page[models.Index], error) {
+		return s.ListPage(ctx, opts)
+	})
+}
+
+// Iterate returns an iterator over all indexes matching opts, fetching the pages
+// lazily from opts.Page (or the first page) onwards.
+func (s *IndexesService) Iterate(ctx context.Context, opts *models.IndexListOptions) iter.Seq2[models.Index, error] {
+	return s.Pager(opts).Items(ctx)
+}
+
+// All retrieves every index matching opts, following the pages to the end.
+func (s *IndexesService) All(ctx context.Context, opts *models.IndexListOptions) ([]models.Index, error) {
+	return s.Pager(opts).All(ctx)
 }
 
 func (s *IndexesService) Create(ctx context.Context, reqBody *models.IndexCreateRequest) (*models.Index, error) {
@@ -100,28 +128,103 @@ func (s *IndexesService) Delete(ctx context.Context, indexID string) error {
 }
 
 // Video management within indexes
-func (s *IndexesService) ListVideos(ctx context.Context, indexID string, filters map[string]string) ([]models.Video, error) {
-	queryParams := ""
-	for key, value := range filters {
-		if queryParams != "" {
-			queryParams += "&"
+
+// ListVideos retrieves a single page of the videos in an index, the first unless opts.Page is set.
+func (s *IndexesService) ListVideos(ctx context.Context, indexID string, opts *models.VideoListOptions) ([]models.Video, error) {
+	page, err := s.ListVideosPage(ctx, indexID, opts)
+	if err != nil {
+		return nil, err
+	}
+	return page.Items, nil
+}
+
+// ListVideosPage retrieves a single page of the videos in an index, the first unless
+// opts.Page is set. The page can fetch its neighbours with the same filters.
+func (s *IndexesService) ListVideosPage(ctx context.Context, indexID string, opts *models.VideoListOptions) (*pagination.Page[models.Video], error) {
+	var base models.VideoListOptions
+	if opts != nil {
+		base = *opts
+	}
+	path := fmt.Sprintf("/indexes/%s/videos", indexID)
+	var fetch pagination.FetchFunc[models.Video]
+	fetch = func(ctx context.Context, cursor pagination.Cursor) (*pagination.Page[models.Video], error) {
+		pageOpts := base
+		pageOpts.Page = cursor.Page
+		query, err := pageOpts.Values()
+		if err != nil {
+			return nil, err
+		}
+		req, err := s.Client.NewRequest(ctx, "GET", withQuery(path, query.Encode()), nil)
+		if err != nil {
+			return nil, err
+		}
+
+		var response models.ListResponse[models.Video]
+		_, err = s.Client.Do(req, &response)
+		if err != nil {
+			return nil, err
 		}
-		queryParams += fmt.Sprintf("%s=%s", key, value)
+
+		return pagination.New(response.Data, response.PageInfo, cursor, fetch), nil
 	}
+	return fetch(ctx, pagination.Cursor{Page: base.Page})
+}
+
+// VideosPager returns a pager over the videos in an index matching opts, starting at opts.Page.
+func (s *IndexesService) VideosPager(indexID string, opts *models.VideoListOptions) *pagination.Pager[models.Video] {
+	return pagination.NewPager(func(ctx context.Context) (*pagination.Page[models.Video], error) {
+		return s.ListVideosPage(ctx, indexID, opts)
+	})
+}
 
-	url := fmt.Sprintf("/indexes/%s/videos", indexID)
-	if queryParams != "" {
-		url += "?" + queryParams
+// IterateVideos returns an iterator over all videos in an index matching opts,
+// fetching the pages lazily from opts.Page (or the first page) onwards.
+func (s *IndexesService) IterateVideos(ctx context.Context, indexID string, opts *models.VideoListOptions) iter.Seq2[models.Video, error] {
+	return s.VideosPager(indexID, opts).Items(ctx)
+}
+
+// AllVideos retrieves every video in an index matching opts, following the pages to the end.
+func (s *IndexesService) AllVideos(ctx context.Context, indexID string, opts *models.VideoListOptions) ([]models.Video, error) {
+	return s.VideosPager(indexID, opts).All(ctx)
+}
+
+func (s *IndexesService) RetrieveVideo(ctx context.Context, indexID, videoID string) (*models.Video, error) {
+	return s.RetrieveVideoWithOptions(ctx, indexID, videoID, nil)
+}
+
+// RetrieveVideoWithOptions retrieves a video together with the optional parts selected by opts.
+func (s *IndexesService) RetrieveVideoWithOptions(ctx context.Context, indexID, videoID string, opts *models.VideoRetrieveOptions) (*models.Video, error) {
+	path := fmt.Sprintf("/indexes/%s/videos/%s", indexID, videoID)
+	req, err := s.Client.NewRequest(ctx, "GET", withQuery(path, opts.Values().Encode()), nil)
+	if err != nil {
+		return nil, err
 	}
 
-	req, err := s.Client.NewRequest(ctx, "GET", url, nil)
+	var video models.Video
+	_, err = s.Client.Do(req, &video)
 	if err != nil {
 		return nil, err
 	}
 
-	var response struct {
-		Data []models.Video `json:"data"`
+	if opts != nil && opts.VisualText {
+		video.VisualText, err = s.RetrieveVideoText(ctx, indexID, videoID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &video, nil
+}
+
+// RetrieveVideoText retrieves the text recognized in the frames of a video.
+func (s *IndexesService) RetrieveVideoText(ctx context.Context, indexID, videoID string) ([]models.VideoTextSegment, error) {
+	path := fmt.Sprintf("/indexes/%s/videos/%s/text-in-video", indexID, videoID)
+	req, err := s.Client.NewRequest(ctx, "GET", path, nil)
+	if err != nil {
+		return nil, err
 	}
+
+	var response models.ListResponse[models.VideoTextSegment]
 	_, err = s.Client.Do(req, &response)
 	if err != nil {
 		return nil, err
@@ -130,20 +233,24 @@ func (s *IndexesService) ListVideos(ctx context.Context, indexID string, filters
 	return response.Data, nil
 }
 
-func (s *IndexesService) RetrieveVideo(ctx context.Context, indexID, videoID string) (*models.Video, error) {
-	path := fmt.Sprintf("/indexes/%s/videos/%s", indexID, videoID)
-	req, err := s.Client.NewRequest(ctx, "GET", path, nil)
+// RetrieveVideoThumbnail retrieves the URL of a thumbnail of a video taken at the given time in seconds.
+func (s *IndexesService) RetrieveVideoThumbnail(ctx context.Context, indexID, videoID string, at float64) (string, error) {
+	path := fmt.Sprintf("/indexes/%s/videos/%s/thumbnail", indexID, videoID)
+	query := url.Values{"time": {strconv.FormatFloat(at, 'f', -1, 64)}}
+	req, err := s.Client.NewRequest(ctx, "GET", withQuery(path, query.Encode()), nil)
 	if err != nil {
-		return nil, err
+		return "", err
 	}
 
-	var video models.Video
-	_, err = s.Client.Do(req, &video)
+	var response struct {
+		Thumbnail string `json:"thumbnail"`
+	}
+	_, err = s.Client.Do(req, &response)
 	if err != nil {
-		return nil, err
+		return "", err
 	}
 
-	return &video, nil
+	return response.Thumbnail, nil
 }
 
 func (s *IndexesService) UpdateVideo(ctx context.Context, indexID, videoID string, reqBody *models.VideoUpdateRequest) (*models.Video, error) {
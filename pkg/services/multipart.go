@@ -0,0 +1,404 @@
+package services
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"net/textproto"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/favourthemaster/twelvelabs-go-sdk/pkg/errors"
+	"github.com/favourthemaster/twelvelabs-go-sdk/pkg/models"
+)
+
+// sniffLen is the number of bytes http.DetectContentType looks at.
+const sniffLen = 512
+
+// formFile is a file part of a multipart form. Its content is read only when
+// the request body is sent, and open is called again for every retry.
+type formFile struct {
+	name        string
+	contentType string
+	size        int64
+	open        func() (io.ReadCloser, error)
+	// once is set when open can only be called once, so the request cannot be retried.
+	once bool
+}
+
+// fileFromPath describes a file on disk as a form part, detecting its MIME type
+// from the extension or, failing that, from its first bytes.
+func fileFromPath(path string) (formFile, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return formFile{}, err
+	}
+	if info.IsDir() {
+		return formFile{}, fmt.Errorf("%s is a directory", path)
+	}
+
+	open := func() (io.ReadCloser, error) { return os.Open(path) }
+	contentType, err := detectContentType(path, open)
+	if err != nil {
+		return formFile{}, err
+	}
+	return formFile{
+		name:        filepath.Base(path),
+		contentType: contentType,
+		size:        info.Size(),
+		open:        open,
+	}, nil
+}
+
+// fileFromMedia describes a media source as a form part. Filename and ContentType
+// set on the source take precedence over the detected values.
+func fileFromMedia(m *models.MediaSource) (formFile, error) {
+	if m.Path != "" {
+		file, err := fileFromPath(m.Path)
+		if err != nil {
+			return formFile{}, err
+		}
+		if m.Filename != "" {
+			file.name = m.Filename
+		}
+		if m.ContentType != "" {
+			file.contentType = m.ContentType
+		}
+		return file, nil
+	}
+
+	file := formFile{name: m.Filename, contentType: m.ContentType}
+	if file.name == "" {
+		file.name = "upload"
+	}
+	switch {
+	case m.Data != nil:
+		data := m.Data
+		file.size = int64(len(data))
+		file.open = func() (io.ReadCloser, error) { return io.NopCloser(bytes.NewReader(data)), nil }
+	case m.Reader != nil:
+		if err := fileFromReader(&file, m.Reader, m.Size); err != nil {
+			return formFile{}, err
+		}
+	default:
+		return formFile{}, fmt.Errorf("media source must set Path, Reader or Data")
+	}
+
+	if file.contentType == "" {
+		contentType, err := detectContentType(file.name, file.open)
+		if err != nil {
+			return formFile{}, err
+		}
+		file.contentType = contentType
+	}
+	return file, nil
+}
+
+// fileFromReader sets up file to stream from r. A size of zero or less is unknown:
+// it is found by seeking when r is seekable and is an error otherwise. Seekable
+// readers are rewound to their current offset on every open so the request can be
+// retried; other readers can be opened only once. The reader is never closed.
+func fileFromReader(file *formFile, r io.Reader, size int64) error {
+	if seeker, ok := r.(io.Seeker); ok {
+		start, err := seeker.Seek(0, io.SeekCurrent)
+		if err != nil {
+			return err
+		}
+		if size <= 0 {
+			end, err := seeker.Seek(0, io.SeekEnd)
+			if err != nil {
+				return err
+			}
+			size = end - start
+		}
+		file.size = size
+		file.open = func() (io.ReadCloser, error) {
+			if _, err := seeker.Seek(start, io.SeekStart); err != nil {
+				return nil, err
+			}
+			return io.NopCloser(r), nil
+		}
+		return nil
+	}
+
+	if size <= 0 {
+		return errors.NewValidationError(fmt.Sprintf("size of %s is required because its reader is not seekable", file.name))
+	}
+	buffered := bufio.NewReaderSize(r, sniffLen)
+	if file.contentType == "" && mime.TypeByExtension(filepath.Ext(file.name)) == "" {
+		// Sniff from the buffered head so the content is still sent in full.
+		head, err := buffered.Peek(sniffLen)
+		if err != nil && err != io.EOF {
+			return err
+		}
+		file.contentType = http.DetectContentType(head)
+	}
+	name, opened := file.name, false
+	file.size = size
+	file.once = true
+	file.open = func() (io.ReadCloser, error) {
+		if opened {
+			return nil, fmt.Errorf("reader for %s cannot be read again", name)
+		}
+		opened = true
+		return io.NopCloser(buffered), nil
+	}
+	return nil
+}
+
+// detectContentType returns the MIME type for a file name, sniffing the content
+// when the extension is unknown.
+func detectContentType(name string, open func() (io.ReadCloser, error)) (string, error) {
+	if byExt := mime.TypeByExtension(filepath.Ext(name)); byExt != "" {
+		return byExt, nil
+	}
+	r, err := open()
+	if err != nil {
+		return "", err
+	}
+	defer r.Close()
+
+	head := make([]byte, sniffLen)
+	n, err := io.ReadFull(r, head)
+	if err != nil && err != io.ErrUnexpectedEOF && err != io.EOF {
+		return "", err
+	}
+	return http.DetectContentType(head[:n]), nil
+}
+
+// formSegment is either a chunk of encoded form data or a file streamed from its source.
+type formSegment struct {
+	data []byte
+	file *formFile
+}
+
+// multipartForm builds a multipart/form-data body whose file parts are streamed
+// rather than buffered, so memory use stays constant regardless of file size.
+// The total length is known up front and the body can be reopened for retries.
+type multipartForm struct {
+	buf      bytes.Buffer
+	writer   *multipart.Writer
+	segments []formSegment
+	closed   bool
+	progress models.ProgressFunc
+}
+
+func newMultipartForm() *multipartForm {
+	f := &multipartForm{}
+	f.writer = multipart.NewWriter(&f.buf)
+	return f
+}
+
+// WriteField adds a plain form field.
+func (f *multipartForm) WriteField(name, value string) error {
+	return f.writer.WriteField(name, value)
+}
+
+// AddFile adds a file part that will be streamed when the request is sent.
+func (f *multipartForm) AddFile(field string, file formFile) error {
+	header := make(textproto.MIMEHeader)
+	header.Set("Content-Disposition", fmt.Sprintf(`form-data; name="%s"; filename="%s"`,
+		escapeQuotes(field), escapeQuotes(file.name)))
+	contentType := file.contentType
+	if contentType == "" {
+		contentType = "application/octet-stream"
+	}
+	header.Set("Content-Type", contentType)
+	if _, err := f.writer.CreatePart(header); err != nil {
+		return err
+	}
+	f.flush()
+	f.segments = append(f.segments, formSegment{file: &file})
+	return nil
+}
+
+// AddMedia adds a media source as a streamed file part.
+func (f *multipartForm) AddMedia(field string, source *models.MediaSource) error {
+	file, err := fileFromMedia(source)
+	if err != nil {
+		return err
+	}
+	return f.AddFile(field, file)
+}
+
+// OnProgress registers a callback that receives upload progress whenever the body is read.
+func (f *multipartForm) OnProgress(fn models.ProgressFunc) {
+	f.progress = fn
+}
+
+// Close writes the closing boundary. No parts can be added afterwards.
+func (f *multipartForm) Close() error {
+	if f.closed {
+		return nil
+	}
+	if err := f.writer.Close(); err != nil {
+		return err
+	}
+	f.flush()
+	f.closed = true
+	return nil
+}
+
+// FormDataContentType returns the Content-Type header value for the form.
+func (f *multipartForm) FormDataContentType() string {
+	return f.writer.FormDataContentType()
+}
+
+// Len returns the total encoded length of the form.
+func (f *multipartForm) Len() int64 {
+	var n int64
+	for _, s := range f.segments {
+		if s.file != nil {
+			n += s.file.size
+		} else {
+			n += int64(len(s.data))
+		}
+	}
+	return n
+}
+
+// Open returns a fresh reader over the encoded form. Files are opened lazily
+// as the reader reaches them and closed when fully read or when the reader is closed.
+func (f *multipartForm) Open() (io.ReadCloser, error) {
+	var body io.ReadCloser = &formReader{segments: f.segments}
+	if f.progress != nil {
+		body = newProgressReader(body, f.Len(), f.progress)
+	}
+	return body, nil
+}
+
+// NewRequest closes the form and builds a streaming request for it with the
+// Content-Type and Content-Length set. GetBody is set so the client can retry
+// the request unless a part comes from a reader that cannot be rewound.
+func (f *multipartForm) NewRequest(ctx context.Context, client ClientInterface, method, path string) (*http.Request, error) {
+	if err := f.Close(); err != nil {
+		return nil, err
+	}
+	body, err := f.Open()
+	if err != nil {
+		return nil, err
+	}
+	req, err := client.NewRequest(ctx, method, path, body)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", f.FormDataContentType())
+	req.ContentLength = f.Len()
+	if f.replayable() {
+		req.GetBody = f.Open
+	}
+	return req, nil
+}
+
+// replayable reports whether every part of the form can be read again.
+func (f *multipartForm) replayable() bool {
+	for _, s := range f.segments {
+		if s.file != nil && s.file.once {
+			return false
+		}
+	}
+	return true
+}
+
+// flush moves the buffered form data into a segment.
+func (f *multipartForm) flush() {
+	if f.buf.Len() == 0 {
+		return
+	}
+	data := bytes.Clone(f.buf.Bytes())
+	f.buf.Reset()
+	f.segments = append(f.segments, formSegment{data: data})
+}
+
+// formReader streams the segments of a multipartForm.
+type formReader struct {
+	segments []formSegment
+	index    int
+	current  io.Reader
+	file     io.ReadCloser
+}
+
+func (r *formReader) Read(p []byte) (int, error) {
+	for r.index < len(r.segments) {
+		if r.current == nil {
+			if err := r.openSegment(); err != nil {
+				return 0, err
+			}
+		}
+		n, err := r.current.Read(p)
+		if err == io.EOF {
+			if short := r.finishSegment(); short != nil {
+				return n, short
+			}
+			if n > 0 {
+				return n, nil
+			}
+			continue
+		}
+		return n, err
+	}
+	return 0, io.EOF
+}
+
+func (r *formReader) openSegment() error {
+	segment := r.segments[r.index]
+	if segment.file == nil {
+		r.current = bytes.NewReader(segment.data)
+		return nil
+	}
+	file, err := segment.file.open()
+	if err != nil {
+		return fmt.Errorf("failed to open %s: %w", segment.file.name, err)
+	}
+	r.file = file
+	r.current = &exactReader{r: io.LimitReader(file, segment.file.size), remaining: segment.file.size}
+	return nil
+}
+
+// finishSegment closes the current file, if any, and advances to the next segment.
+func (r *formReader) finishSegment() error {
+	var err error
+	if er, ok := r.current.(*exactReader); ok && er.remaining > 0 {
+		err = fmt.Errorf("file %s is shorter than its declared size: %w", r.segments[r.index].file.name, io.ErrUnexpectedEOF)
+	}
+	if r.file != nil {
+		_ = r.file.Close()
+		r.file = nil
+	}
+	r.current = nil
+	r.index++
+	return err
+}
+
+func (r *formReader) Close() error {
+	if r.file != nil {
+		err := r.file.Close()
+		r.file = nil
+		return err
+	}
+	return nil
+}
+
+// exactReader tracks how much of a declared length is left to read.
+type exactReader struct {
+	r         io.Reader
+	remaining int64
+}
+
+func (e *exactReader) Read(p []byte) (int, error) {
+	n, err := e.r.Read(p)
+	e.remaining -= int64(n)
+	return n, err
+}
+
+var quoteEscaper = strings.NewReplacer("\\", "\\\\", `"`, "\\\"")
+
+func escapeQuotes(s string) string {
+	return quoteEscaper.Replace(s)
+}
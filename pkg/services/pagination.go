@@ -0,0 +1,9 @@
+package services
+
+// withQuery appends the encoded query parameters to path.
+func withQuery(path, query string) string {
+	if query == "" {
+		return path
+	}
+	return path + "?" + query
+}
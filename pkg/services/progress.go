@@ -0,0 +1,57 @@
+package services
+
+import (
+	"io"
+	"time"
+
+	"github.com/favourthemaster/twelvelabs-go-sdk/pkg/models"
+)
+
+// progressInterval is the minimum time between two progress reports.
+const progressInterval = 200 * time.Millisecond
+
+// progressReader reports the number of bytes read from the request body.
+// A new progressReader is created for every attempt, so retries start from zero.
+type progressReader struct {
+	r        io.ReadCloser
+	total    int64
+	sent     int64
+	start    time.Time
+	last     time.Time
+	report   models.ProgressFunc
+	finished bool
+}
+
+func newProgressReader(r io.ReadCloser, total int64, report models.ProgressFunc) *progressReader {
+	now := time.Now()
+	return &progressReader{r: r, total: total, start: now, last: now, report: report}
+}
+
+func (p *progressReader) Read(b []byte) (int, error) {
+	n, err := p.r.Read(b)
+	p.sent += int64(n)
+
+	now := time.Now()
+	done := err == io.EOF || (p.total > 0 && p.sent >= p.total)
+	if (done && !p.finished) || now.Sub(p.last) >= progressInterval {
+		p.last = now
+		p.finished = done
+		p.report(p.progress(now))
+	}
+	return n, err
+}
+
+func (p *progressReader) Close() error {
+	return p.r.Close()
+}
+
+func (p *progressReader) progress(now time.Time) models.UploadProgress {
+	progress := models.UploadProgress{BytesSent: p.sent, TotalBytes: p.total}
+	if elapsed := now.Sub(p.start).Seconds(); elapsed > 0 {
+		progress.Throughput = float64(p.sent) / elapsed
+	}
+	if progress.Throughput > 0 && p.total > p.sent {
+		progress.ETA = time.Duration(float64(p.total-p.sent) / progress.Throughput * float64(time.Second))
+	}
+	return progress
+}
@@ -1,14 +1,13 @@
 package services
 
 import (
-	"bytes"
 	"context"
-	"fmt"
-	"io"
-	"mime/multipart"
-	"os"
+	"log/slog"
+	"net/url"
 
 	"github.com/favourthemaster/twelvelabs-go-sdk/pkg/models"
+	"github.com/favourthemaster/twelvelabs-go-sdk/pkg/pagination"
+	"github.com/favourthemaster/twelvelabs-go-sdk/pkg/telemetry"
 )
 
 type SearchService struct {
@@ -16,73 +15,18 @@ type SearchService struct {
 }
 
 func (s *SearchService) Query(ctx context.Context, reqBody *models.SearchQueryRequest) (*models.SearchResponse, error) {
-	var b bytes.Buffer
-	w := multipart.NewWriter(&b)
+	ctx = telemetry.WithIndexID(ctx, reqBody.IndexID)
 
-	// Add required fields
-	if err := w.WriteField("index_id", reqBody.IndexID); err != nil {
-		return nil, fmt.Errorf("failed to write index_id field: %w", err)
-	}
-
-	// Add optional query fields
-	if reqBody.QueryText != "" {
-		if err := w.WriteField("query_text", reqBody.QueryText); err != nil {
-			return nil, fmt.Errorf("failed to write query_text field: %w", err)
-		}
-	}
-
-	if reqBody.QueryMediaType != "" {
-		if err := w.WriteField("query_media_type", reqBody.QueryMediaType); err != nil {
-			return nil, fmt.Errorf("failed to write query_media_type field: %w", err)
-		}
-	}
-
-	if reqBody.QueryMediaURL != "" {
-		if err := w.WriteField("query_media_url", reqBody.QueryMediaURL); err != nil {
-			return nil, fmt.Errorf("failed to write query_media_url field: %w", err)
-		}
-	}
-
-	// Handle file upload if provided
-	if reqBody.QueryMediaFile != "" {
-		file, err := os.Open(reqBody.QueryMediaFile)
-		if err != nil {
-			return nil, fmt.Errorf("failed to open query media file: %w", err)
-		}
-		defer func(file *os.File) {
-			err := file.Close()
-			if err != nil {
-				fmt.Printf("failed to close query media file: %v\n", err)
-			}
-		}(file)
-
-		part, err := w.CreateFormFile("query_media_file", reqBody.QueryMediaFile)
-		if err != nil {
-			return nil, fmt.Errorf("failed to create form file: %w", err)
-		}
-
-		if _, err = io.Copy(part, file); err != nil {
-			return nil, fmt.Errorf("failed to copy file content: %w", err)
-		}
-	}
-
-	// Add search options
-	for _, option := range reqBody.SearchOptions {
-		if err := w.WriteField("search_options", option); err != nil {
-			return nil, fmt.Errorf("failed to write search_options field: %w", err)
-		}
-	}
-
-	err := w.Close()
-	if err != nil {
+	w := newMultipartForm()
+	w.OnProgress(reqBody.OnProgress)
+	if err := encodeForm(w, reqBody); err != nil {
 		return nil, err
 	}
 
-	req, err := s.Client.NewRequest(ctx, "POST", "/search", &b)
+	req, err := w.NewRequest(ctx, s.Client, "POST", "/search")
 	if err != nil {
 		return nil, err
 	}
-	req.Header.Set("Content-Type", w.FormDataContentType())
 
 	var response models.SearchResponse
 	_, err = s.Client.Do(req, &response)
@@ -90,98 +34,50 @@ func (s *SearchService) Query(ctx context.Context, reqBody *models.SearchQueryRe
 		return nil, err
 	}
 
-	// Add debugging to see what we actually received
-	fmt.Printf("DEBUG: Raw response: %+v\n", response)
-	fmt.Printf("DEBUG: Data length: %d\n", len(response.Data))
-	if response.SearchPool != nil {
-		fmt.Printf("DEBUG: SearchPool: %+v\n", *response.SearchPool)
-	} else {
-		fmt.Printf("DEBUG: SearchPool is nil\n")
-	}
-	if response.PageInfo != nil {
-		fmt.Printf("DEBUG: PageInfo: %+v\n", *response.PageInfo)
-	} else {
-		fmt.Printf("DEBUG: PageInfo is nil\n")
-	}
+	s.Client.Logger().Debug("search query completed",
+		slog.String("index_id", reqBody.IndexID),
+		slog.Int("results", len(response.Data)),
+		slog.Bool("has_next_page", response.PageInfo != nil && response.PageInfo.NextPageToken != ""))
 
 	return &response, nil // Return the complete response, not just response.Data
 }
 
 func (s *SearchService) Search(ctx context.Context, request *models.SearchRequest) (*models.SearchResponse, error) {
-	var b bytes.Buffer
-	w := multipart.NewWriter(&b)
-
-	// Add required fields
-	if err := w.WriteField("index_id", request.IndexID); err != nil {
-		return nil, fmt.Errorf("failed to write index_id field: %w", err)
-	}
-
-	// Add optional query fields
-	if request.QueryText != "" {
-		if err := w.WriteField("query_text", request.QueryText); err != nil {
-			return nil, fmt.Errorf("failed to write query_text field: %w", err)
-		}
-	}
+	ctx = telemetry.WithIndexID(ctx, request.IndexID)
 
-	if request.QueryMediaType != "" {
-		if err := w.WriteField("query_media_type", request.QueryMediaType); err != nil {
-			return nil, fmt.Errorf("failed to write query_media_type field: %w", err)
-		}
-	}
-
-	if request.QueryMediaURL != "" {
-		if err := w.WriteField("query_media_url", request.QueryMediaURL); err != nil {
-			return nil, fmt.Errorf("failed to write query_media_url field: %w", err)
-		}
+	w := newMultipartForm()
+	w.OnProgress(request.OnProgress)
+	if err := encodeForm(w, request); err != nil {
+		return nil, err
 	}
 
-	// Handle file upload if provided
-	if request.QueryMediaFile != "" {
-		file, err := os.Open(request.QueryMediaFile)
-		if err != nil {
-			return nil, fmt.Errorf("failed to open query media file: %w", err)
-		}
-		defer func(file *os.File) {
-			err := file.Close()
-			if err != nil {
-				fmt.Printf("failed to close query media file: %v\n", err)
-			}
-		}(file)
-
-		part, err := w.CreateFormFile("query_media_file", request.QueryMediaFile)
-		if err != nil {
-			return nil, fmt.Errorf("failed to create form file: %w", err)
-		}
-
-		if _, err = io.Copy(part, file); err != nil {
-			return nil, fmt.Errorf("failed to copy file content: %w", err)
-		}
+	req, err := w.NewRequest(ctx, s.Client, "POST", "/search")
+	if err != nil {
+		return nil, err
 	}
 
-	// Add search options
-	for _, option := range request.SearchOptions {
-		if err := w.WriteField("search_options", option); err != nil {
-			return nil, fmt.Errorf("failed to write search_options field: %w", err)
-		}
+	var response models.SearchResponse
+	_, err = s.Client.Do(req, &response)
+	if err != nil {
+		return nil, err
 	}
 
-	// Add other optional fields
-	if request.PageLimit > 0 {
-		if err := w.WriteField("page_limit", fmt.Sprintf("%d", request.PageLimit)); err != nil {
-			return nil, fmt.Errorf("failed to write page_limit field: %w", err)
-		}
-	}
+	return &response, nil
+}
 
-	err := w.Close()
-	if err != nil {
-		return nil, err
+// Retrieve fetches the page of an earlier search identified by pageToken. The
+// token is escaped, so it may contain any character.
+func (s *SearchService) Retrieve(ctx context.Context, pageToken string) (*models.SearchResponse, error) {
+	query := url.Values{}
+	if pageToken != "" {
+		query.Set("page_token", pageToken)
 	}
+	path := withQuery("/search/"+url.PathEscape(pageToken), query.Encode())
 
-	req, err := s.Client.NewRequest(ctx, "POST", "/search", &b)
+	req, err := s.Client.NewRequest(ctx, "GET", path, nil)
 	if err != nil {
 		return nil, err
 	}
-	req.Header.Set("Content-Type", w.FormDataContentType())
 
 	var response models.SearchResponse
 	_, err = s.Client.Do(req, &response)
@@ -192,27 +88,41 @@ func (s *SearchService) Search(ctx context.Context, request *models.SearchReques
 	return &response, nil
 }
 
-func (s *SearchService) Retrieve(ctx context.Context, pageToken string) (*models.SearchResponse, error) {
-	queryParams := ""
-	if pageToken != "" {
-		queryParams += fmt.Sprintf("page_token=%s", pageToken)
-	}
-
-	url := fmt.Sprintf("/search/%s", pageToken)
-	if queryParams != "" {
-		url += "?" + queryParams
+// QueryPage runs a search and returns its first page of results. The page fetches
+// its neighbours with the page tokens of the search.
+func (s *SearchService) QueryPage(ctx context.Context, reqBody *models.SearchQueryRequest) (*pagination.Page[models.SearchResult], error) {
+	response, err := s.Query(ctx, reqBody)
+	if err != nil {
+		return nil, err
 	}
+	return s.newPage(response, pagination.Cursor{}), nil
+}
 
-	req, err := s.Client.NewRequest(ctx, "GET", url, nil)
+// SearchPage runs a search and returns a page of results, the first unless
+// request.PageToken is set. The page fetches its neighbours with the page tokens
+// of the search.
+func (s *SearchService) SearchPage(ctx context.Context, request *models.SearchRequest) (*pagination.Page[models.SearchResult], error) {
+	response, err := s.Search(ctx, request)
 	if err != nil {
 		return nil, err
 	}
+	return s.newPage(response, pagination.Cursor{Token: request.PageToken}), nil
+}
 
-	var response models.SearchResponse
-	_, err = s.Client.Do(req, &response)
+// RetrievePage retrieves the page of search results identified by pageToken.
+func (s *SearchService) RetrievePage(ctx context.Context, pageToken string) (*pagination.Page[models.SearchResult], error) {
+	return s.fetchPage(ctx, pagination.Cursor{Token: pageToken})
+}
+
+// fetchPage retrieves a page of an earlier search by its token.
+func (s *SearchService) fetchPage(ctx context.Context, cursor pagination.Cursor) (*pagination.Page[models.SearchResult], error) {
+	response, err := s.Retrieve(ctx, cursor.Token)
 	if err != nil {
 		return nil, err
 	}
+	return s.newPage(response, cursor), nil
+}
 
-	return &response, nil
+func (s *SearchService) newPage(response *models.SearchResponse, cursor pagination.Cursor) *pagination.Page[models.SearchResult] {
+	return pagination.New(response.Data, response.PageInfo, cursor, s.fetchPage)
 }
@@ -0,0 +1,154 @@
+package services_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"testing"
+
+	"github.com/favourthemaster/twelvelabs-go-sdk/pkg/client"
+	"github.com/favourthemaster/twelvelabs-go-sdk/pkg/models"
+)
+
+// searchServer records the form fields of every /search request it receives.
+func searchServer(t *testing.T) (*client.Client, *url.Values) {
+	t.Helper()
+	var received url.Values
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/search" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			http.NotFound(w, r)
+			return
+		}
+		if err := r.ParseMultipartForm(1 << 20); err != nil {
+			t.Errorf("parse form: %v", err)
+		}
+		received = r.MultipartForm.Value
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"data":[]}`))
+	}))
+	t.Cleanup(server.Close)
+	return client.NewClient(&client.Options{BaseURL: server.URL, APIKey: "test-key"}), &received
+}
+
+// searchOptionFields are the form fields of every search option set in the tests.
+var searchOptionFields = url.Values{
+	"index_id":                {"index-1"},
+	"query_text":              {"goal"},
+	"filter":                  {`{"user_metadata.category":"sports"}`},
+	"search_options":          {"visual", "transcription"},
+	"threshold":               {"medium"},
+	"sort_option":             {"clip_count"},
+	"conversation_option":     {"semantic"},
+	"adjust_confidence_level": {"0.7"},
+	"include_clips":           {"true"},
+	"group_by":                {"video"},
+	"operator":                {"and"},
+	"transcription_options":   {"lexical", "semantic"},
+	"page_limit":              {"25"},
+}
+
+func TestSearchSendsEveryOption(t *testing.T) {
+	c, received := searchServer(t)
+
+	_, err := c.Search.Search(context.Background(), &models.SearchRequest{
+		IndexID:               "index-1",
+		QueryText:             "goal",
+		Filter:                `{"user_metadata.category":"sports"}`,
+		SearchOptions:         []string{"visual", "transcription"},
+		Threshold:             "medium",
+		SortOption:            "clip_count",
+		ConversationOption:    "semantic",
+		AdjustConfidenceLevel: 0.7,
+		IncludeClips:          true,
+		GroupBy:               models.SearchGroupByVideo,
+		Operator:              models.SearchOperatorAnd,
+		TranscriptionOptions:  []string{"lexical", "semantic"},
+		PageLimit:             25,
+		PageToken:             "token-1",
+	})
+	if err != nil {
+		t.Fatalf("Search: %v", err)
+	}
+
+	want := url.Values{"page_token": {"token-1"}}
+	for key, values := range searchOptionFields {
+		want[key] = values
+	}
+	if !reflect.DeepEqual(*received, want) {
+		t.Errorf("form = %v, want %v", *received, want)
+	}
+}
+
+func TestQuerySendsEveryOption(t *testing.T) {
+	c, received := searchServer(t)
+
+	_, err := c.Search.Query(context.Background(), &models.SearchQueryRequest{
+		IndexID:               "index-1",
+		QueryText:             "goal",
+		Filter:                `{"user_metadata.category":"sports"}`,
+		SearchOptions:         []string{"visual", "transcription"},
+		Threshold:             "medium",
+		SortOption:            "clip_count",
+		ConversationOption:    "semantic",
+		AdjustConfidenceLevel: 0.7,
+		IncludeClips:          true,
+		GroupBy:               models.SearchGroupByVideo,
+		Operator:              models.SearchOperatorAnd,
+		TranscriptionOptions:  []string{"lexical", "semantic"},
+		PageLimit:             25,
+	})
+	if err != nil {
+		t.Fatalf("Query: %v", err)
+	}
+	if !reflect.DeepEqual(*received, searchOptionFields) {
+		t.Errorf("form = %v, want %v", *received, searchOptionFields)
+	}
+}
+
+func TestSearchLeavesZeroValuesOut(t *testing.T) {
+	c, received := searchServer(t)
+
+	_, err := c.Search.Search(context.Background(), &models.SearchRequest{
+		IndexID:       "index-1",
+		QueryText:     "goal",
+		SearchOptions: []string{"visual"},
+	})
+	if err != nil {
+		t.Fatalf("Search: %v", err)
+	}
+
+	want := url.Values{
+		"index_id":       {"index-1"},
+		"query_text":     {"goal"},
+		"search_options": {"visual"},
+	}
+	if !reflect.DeepEqual(*received, want) {
+		t.Errorf("form = %v, want %v", *received, want)
+	}
+}
+
+func TestRetrieveEscapesPageToken(t *testing.T) {
+	const token = "a/b?c=d&e#f g"
+	var path, query string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path = r.URL.EscapedPath()
+		query = r.URL.Query().Get("page_token")
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"data":[]}`))
+	}))
+	defer server.Close()
+	c := client.NewClient(&client.Options{BaseURL: server.URL, APIKey: "test-key"})
+
+	if _, err := c.Search.Retrieve(context.Background(), token); err != nil {
+		t.Fatalf("Retrieve: %v", err)
+	}
+	if want := "/search/" + url.PathEscape(token); path != want {
+		t.Errorf("path = %q, want %q", path, want)
+	}
+	if query != token {
+		t.Errorf("page_token = %q, want %q", query, token)
+	}
+}
@@ -1,107 +1,89 @@
 package services
 
 import (
-	"bytes"
 	"context"
 	"fmt"
-	"io"
-	"mime/multipart"
-	"os"
+	"iter"
+	"log/slog"
 	"time"
 
 	"github.com/favourthemaster/twelvelabs-go-sdk/pkg/models"
+	"github.com/favourthemaster/twelvelabs-go-sdk/pkg/pagination"
+	"github.com/favourthemaster/twelvelabs-go-sdk/pkg/poll"
+	"github.com/favourthemaster/twelvelabs-go-sdk/pkg/telemetry"
 )
 
 type TasksService struct {
 	Client ClientInterface
 }
 
-func (s *TasksService) List(ctx context.Context, filters map[string]string) ([]models.Task, error) {
-	queryParams := ""
-	for key, value := range filters {
-		if queryParams != "" {
-			queryParams += "&"
-		}
-		queryParams += fmt.Sprintf("%s=%s", key, value)
-	}
-
-	url := "/tasks"
-	if queryParams != "" {
-		url += "?" + queryParams
-	}
-
-	req, err := s.Client.NewRequest(ctx, "GET", url, nil)
-	if err != nil {
-		return nil, err
-	}
-
-	var response struct {
-		Data []models.Task `json:"data"`
-	}
-	_, err = s.Client.Do(req, &response)
+// List retrieves a single page of tasks, the first unless opts.Page is set.
+func (s *TasksService) List(ctx context.Context, opts *models.TaskListOptions) ([]models.Task, error) {
+	page, err := s.ListPage(ctx, opts)
 	if err != nil {
 		return nil, err
 	}
-
-	return response.Data, nil
+	return page.Items, nil
 }
 
-func (s *TasksService) Create(ctx context.Context, reqBody *models.TasksCreateRequest) (*models.Task, error) {
-	var b bytes.Buffer
-	w := multipart.NewWriter(&b)
-
-	// Add index_id field
-	if err := w.WriteField("index_id", reqBody.IndexID); err != nil {
-		return nil, fmt.Errorf("failed to write index_id field: %w", err)
-	}
-
-	// Add video_file field if provided
-	if reqBody.VideoFile != "" {
-		file, err := os.Open(reqBody.VideoFile)
+// ListPage retrieves a single page of tasks, the first unless opts.Page is set.
+// The page can fetch its neighbours with the same filters.
+func (s *TasksService) ListPage(ctx context.Context, opts *models.TaskListOptions) (*pagination.Page[models.Task], error) {
+	var base models.TaskListOptions
+	if opts != nil {
+		base = *opts
+	}
+	var fetch pagination.FetchFunc[models.Task]
+	fetch = func(ctx context.Context, cursor pagination.Cursor) (*pagination.Page[models.Task], error) {
+		pageOpts := base
+		pageOpts.Page = cursor.Page
+		req, err := s.Client.NewRequest(ctx, "GET", withQuery("/tasks", pageOpts.Values().Encode()), nil)
 		if err != nil {
-			return nil, fmt.Errorf("failed to open video file: %w", err)
+			return nil, err
 		}
-		defer func(file *os.File) {
-			err := file.Close()
-			if err != nil {
-				fmt.Printf("failed to close video file: %v\n", err)
-			}
-		}(file)
-
-		part, err := w.CreateFormFile("video_file", reqBody.VideoFile)
+
+		var response models.ListResponse[models.Task]
+		_, err = s.Client.Do(req, &response)
 		if err != nil {
-			return nil, fmt.Errorf("failed to create form file: %w", err)
+			return nil, err
 		}
 
-		if _, err = io.Copy(part, file); err != nil {
-			return nil, fmt.Errorf("failed to copy file content: %w", err)
-		}
+		return pagination.New(response.Data, response.PageInfo, cursor, fetch), nil
 	}
+	return fetch(ctx, pagination.Cursor{Page: base.Page})
+}
 
-	// Add video_url field if provided
-	if reqBody.VideoURL != "" {
-		if err := w.WriteField("video_url", reqBody.VideoURL); err != nil {
-			return nil, fmt.Errorf("failed to write video_url field: %w", err)
-		}
-	}
+// Pager returns a pager over the tasks matching opts, starting at opts.Page.
+func (s *TasksService) Pager(opts *models.TaskListOptions) *pagination.Pager[models.Task] {
+	return pagination.NewPager(func(ctx context.Context) (*pagination.Page[models.Task], error) {
+		return s.ListPage(ctx, opts)
+	})
+}
 
-	// Add additional optional fields...
-	if reqBody.EnableVideoStream {
-		if err := w.WriteField("enable_video_stream", "true"); err != nil {
-			return nil, fmt.Errorf("failed to write enable_video_stream field: %w", err)
-		}
-	}
+// Iterate returns an iterator over all tasks matching opts, fetching the pages
+// lazily from opts.Page (or the first page) onwards.
+func (s *TasksService) Iterate(ctx context.Context, opts *models.TaskListOptions) iter.Seq2[models.Task, error] {
+	return s.Pager(opts).Items(ctx)
+}
 
-	err := w.Close()
-	if err != nil {
+// All retrieves every task matching opts, following the pages to the end.
+func (s *TasksService) All(ctx context.Context, opts *models.TaskListOptions) ([]models.Task, error) {
+	return s.Pager(opts).All(ctx)
+}
+
+func (s *TasksService) Create(ctx context.Context, reqBody *models.TasksCreateRequest) (*models.Task, error) {
+	ctx = telemetry.WithIndexID(ctx, reqBody.IndexID)
+
+	w := newMultipartForm()
+	w.OnProgress(reqBody.OnProgress)
+	if err := encodeForm(w, reqBody); err != nil {
 		return nil, err
 	}
 
-	req, err := s.Client.NewRequest(ctx, "POST", "/tasks", &b)
+	req, err := w.NewRequest(ctx, s.Client, "POST", "/tasks")
 	if err != nil {
 		return nil, err
 	}
-	req.Header.Set("Content-Type", w.FormDataContentType())
 
 	var task models.Task
 	_, err = s.Client.Do(req, &task)
@@ -139,28 +121,44 @@ func (s *TasksService) Delete(ctx context.Context, id string) error {
 	return err
 }
 
-func (s *TasksService) WaitForDone(ctx context.Context, id string, interval time.Duration, callback func(*models.Task)) (*models.Task, error) {
-	for {
-		// Check for context cancellation
-		select {
-		case <-ctx.Done():
-			return nil, ctx.Err()
-		default:
-		}
-
-		task, err := s.Retrieve(ctx, id)
-		if err != nil {
-			return nil, err
-		}
-
-		if callback != nil {
+// WaitForDone polls the task until it is ready, failed or in error, starting at
+// interval and backing off while its status does not change. callback receives
+// the task after every poll. A task that failed to index is returned with its
+// status and no error.
+func (s *TasksService) WaitForDone(ctx context.Context, id string, interval time.Duration, callback func(*models.Task)) (_ *models.Task, err error) {
+	ctx, span := s.Client.Telemetry().StartSpan(ctx, "twelvelabs.tasks.wait_for_done", telemetry.AttrTaskID.String(id))
+	defer func() { telemetry.EndSpan(span, err) }()
+
+	poller := &poll.Poller[*models.Task]{Interval: interval}
+	if callback != nil {
+		poller.OnPoll = func(task *models.Task) error {
 			callback(task)
+			return nil
 		}
+	}
+	return s.Wait(ctx, id, poller)
+}
 
-		if task.Status == "ready" || task.Status == "failed" || task.Status == "error" {
-			return task, nil
-		}
-
-		time.Sleep(interval)
+// Wait polls the task with poller until it is ready, failed or in error. The
+// poller's Done, State and Logger are filled in when unset, so only the timing
+// and hooks need to be configured.
+func (s *TasksService) Wait(ctx context.Context, id string, poller *poll.Poller[*models.Task]) (*models.Task, error) {
+	p := *poller
+	if p.Done == nil {
+		p.Done = taskDone
+	}
+	if p.State == nil {
+		p.State = func(task *models.Task) string { return string(task.Status) }
+	}
+	if p.Logger == nil {
+		p.Logger = s.Client.Logger().With(slog.String("task_id", id))
 	}
+	return p.Poll(ctx, func(ctx context.Context) (*models.Task, error) {
+		return s.Retrieve(ctx, id)
+	})
+}
+
+// taskDone reports whether the task has reached a status that no longer changes.
+func taskDone(task *models.Task) bool {
+	return task.Status.IsTerminal()
 }
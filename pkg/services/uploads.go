@@ -0,0 +1,127 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+
+	"github.com/favourthemaster/twelvelabs-go-sdk/pkg/models"
+)
+
+type UploadsService struct {
+	Client ClientInterface
+}
+
+func (s *UploadsService) CreateSession(ctx context.Context, reqBody *models.UploadSessionCreateRequest) (*models.UploadSession, error) {
+	req, err := s.Client.NewRequest(ctx, "POST", "/assets/multipart-uploads", reqBody)
+	if err != nil {
+		return nil, err
+	}
+
+	var session models.UploadSession
+	_, err = s.Client.Do(req, &session)
+	if err != nil {
+		return nil, err
+	}
+
+	return &session, nil
+}
+
+func (s *UploadsService) RetrieveSession(ctx context.Context, uploadID string) (*models.UploadSessionStatus, error) {
+	path := fmt.Sprintf("/assets/multipart-uploads/%s", uploadID)
+	req, err := s.Client.NewRequest(ctx, "GET", path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var status models.UploadSessionStatus
+	_, err = s.Client.Do(req, &status)
+	if err != nil {
+		return nil, err
+	}
+
+	return &status, nil
+}
+
+// GetUploadURLs requests fresh pre-signed URLs for count chunks starting at chunk index start.
+func (s *UploadsService) GetUploadURLs(ctx context.Context, uploadID string, start, count int) ([]models.UploadURL, error) {
+	path := fmt.Sprintf("/assets/multipart-uploads/%s/presigned-urls", uploadID)
+	body := map[string]int{"start": start, "count": count}
+	req, err := s.Client.NewRequest(ctx, "POST", path, body)
+	if err != nil {
+		return nil, err
+	}
+
+	var response struct {
+		UploadURLs []models.UploadURL `json:"upload_urls"`
+	}
+	_, err = s.Client.Do(req, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.UploadURLs, nil
+}
+
+// UploadChunk sends a chunk to its pre-signed URL and returns the ETag reported by storage.
+// The request is sent with DoExternal, so neither the API key nor the API's
+// middlewares, retries and rate limits apply to the storage URL.
+func (s *UploadsService) UploadChunk(ctx context.Context, url string, chunk *io.SectionReader) (string, error) {
+	req, err := http.NewRequestWithContext(ctx, "PUT", url, io.NewSectionReader(chunk, 0, chunk.Size()))
+	if err != nil {
+		return "", err
+	}
+	req.ContentLength = chunk.Size()
+	req.GetBody = func() (io.ReadCloser, error) {
+		return io.NopCloser(io.NewSectionReader(chunk, 0, chunk.Size())), nil
+	}
+
+	res, err := s.Client.DoExternal(req)
+	if err != nil {
+		return "", err
+	}
+	defer func() {
+		if err := res.Body.Close(); err != nil {
+			s.Client.Logger().Warn("twelvelabs: failed to close response body", slog.Any("error", err))
+		}
+	}()
+	_, _ = io.Copy(io.Discard, res.Body)
+
+	etag := res.Header.Get("ETag")
+	if etag == "" {
+		return "", fmt.Errorf("upload response for chunk is missing the ETag header")
+	}
+	return etag, nil
+}
+
+// ReportChunks records uploaded chunks with the session. Once every chunk has been
+// reported the session completes and its asset is processed.
+func (s *UploadsService) ReportChunks(ctx context.Context, uploadID string, chunks []models.CompletedChunk) error {
+	path := fmt.Sprintf("/assets/multipart-uploads/%s", uploadID)
+	body := map[string][]models.CompletedChunk{"completed_chunks": chunks}
+	req, err := s.Client.NewRequest(ctx, "POST", path, body)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.Client.Do(req, nil)
+	return err
+}
+
+func (s *UploadsService) RetrieveAsset(ctx context.Context, assetID string) (*models.Asset, error) {
+	path := fmt.Sprintf("/assets/%s", assetID)
+	req, err := s.Client.NewRequest(ctx, "GET", path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var asset models.Asset
+	_, err = s.Client.Do(req, &asset)
+	if err != nil {
+		return nil, err
+	}
+
+	return &asset, nil
+}
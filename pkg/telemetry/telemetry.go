@@ -0,0 +1,296 @@
+// Package telemetry provides optional OpenTelemetry tracing and metrics for the SDK.
+// Instrumentation is disabled unless a TracerProvider or MeterProvider is configured
+// on the client options.
+package telemetry
+
+import (
+	"context"
+	stderrors "errors"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/metric"
+	metricnoop "go.opentelemetry.io/otel/metric/noop"
+	"go.opentelemetry.io/otel/trace"
+	tracenoop "go.opentelemetry.io/otel/trace/noop"
+
+	"github.com/favourthemaster/twelvelabs-go-sdk/pkg/errors"
+)
+
+// ScopeName is the instrumentation scope used for the SDK's tracer and meter.
+const ScopeName = "github.com/favourthemaster/twelvelabs-go-sdk"
+
+// Attribute keys recorded on spans and metrics.
+const (
+	AttrEndpoint     = attribute.Key("twelvelabs.endpoint")
+	AttrMethod       = attribute.Key("http.request.method")
+	AttrStatusCode   = attribute.Key("http.response.status_code")
+	AttrIndexID      = attribute.Key("twelvelabs.index_id")
+	AttrVideoID      = attribute.Key("twelvelabs.video_id")
+	AttrTaskID       = attribute.Key("twelvelabs.task_id")
+	AttrRetryCount   = attribute.Key("twelvelabs.retry_count")
+	AttrUploadBytes  = attribute.Key("twelvelabs.upload.bytes")
+	AttrErrorType    = attribute.Key("error.type")
+	AttrOperation    = attribute.Key("twelvelabs.operation")
+	AttrPollAttempts = attribute.Key("twelvelabs.poll.attempts")
+)
+
+// Instrumentation holds the tracer and metric instruments used by the client.
+// The zero value is not usable; create one with New.
+type Instrumentation struct {
+	tracer          trace.Tracer
+	requestDuration metric.Float64Histogram
+	requestErrors   metric.Int64Counter
+	uploadedBytes   metric.Int64Counter
+	outputTokens    metric.Int64Counter
+}
+
+// New creates instrumentation from the given providers. Nil providers are replaced by no-op ones.
+func New(tp trace.TracerProvider, mp metric.MeterProvider) *Instrumentation {
+	if tp == nil {
+		tp = tracenoop.NewTracerProvider()
+	}
+	if mp == nil {
+		mp = metricnoop.NewMeterProvider()
+	}
+
+	meter := mp.Meter(ScopeName)
+	inst := &Instrumentation{tracer: tp.Tracer(ScopeName)}
+
+	// Instrument creation only fails for invalid names, so fall back to no-ops defensively.
+	var err error
+	noop := metricnoop.Meter{}
+	if inst.requestDuration, err = meter.Float64Histogram("twelvelabs.client.request.duration",
+		metric.WithUnit("s"), metric.WithDescription("Duration of TwelveLabs API requests, including retries.")); err != nil {
+		inst.requestDuration, _ = noop.Float64Histogram("")
+	}
+	if inst.requestErrors, err = meter.Int64Counter("twelvelabs.client.request.errors",
+		metric.WithDescription("Failed TwelveLabs API requests by error type.")); err != nil {
+		inst.requestErrors, _ = noop.Int64Counter("")
+	}
+	if inst.uploadedBytes, err = meter.Int64Counter("twelvelabs.client.uploaded_bytes",
+		metric.WithUnit("By"), metric.WithDescription("Request body bytes sent to the TwelveLabs API.")); err != nil {
+		inst.uploadedBytes, _ = noop.Int64Counter("")
+	}
+	if inst.outputTokens, err = meter.Int64Counter("twelvelabs.analyze.output_tokens",
+		metric.WithDescription("Output tokens reported by analyze, summarize and gist requests.")); err != nil {
+		inst.outputTokens, _ = noop.Int64Counter("")
+	}
+	return inst
+}
+
+// Noop returns instrumentation that records nothing.
+func Noop() *Instrumentation {
+	return New(nil, nil)
+}
+
+// Tracer returns the tracer used for SDK spans.
+func (i *Instrumentation) Tracer() trace.Tracer {
+	return i.tracer
+}
+
+// StartSpan starts an internal span, e.g. around a polling loop, so the
+// individual API calls it makes become its children.
+func (i *Instrumentation) StartSpan(ctx context.Context, name string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	return i.tracer.Start(ctx, name, trace.WithAttributes(attrs...))
+}
+
+// StartRequest starts a client span for an API call.
+func (i *Instrumentation) StartRequest(ctx context.Context, method, endpoint string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	attrs = append(attrs, AttrMethod.String(method), AttrEndpoint.String(endpoint))
+	attrs = append(attrs, resourceAttributes(ctx)...)
+	return i.tracer.Start(ctx, method+" "+endpoint, trace.WithSpanKind(trace.SpanKindClient), trace.WithAttributes(attrs...))
+}
+
+// RequestResult describes the outcome of an API call for EndRequest.
+type RequestResult struct {
+	Method      string
+	Endpoint    string
+	StatusCode  int
+	Retries     int
+	UploadBytes int64
+	Duration    time.Duration
+	Err         error
+}
+
+// EndRequest records the result of an API call on span and in the request metrics, then ends span.
+func (i *Instrumentation) EndRequest(ctx context.Context, span trace.Span, result RequestResult) {
+	attrs := []attribute.KeyValue{
+		AttrMethod.String(result.Method),
+		AttrEndpoint.String(result.Endpoint),
+	}
+	if result.StatusCode > 0 {
+		attrs = append(attrs, AttrStatusCode.Int(result.StatusCode))
+	}
+	if result.Err != nil {
+		attrs = append(attrs, AttrErrorType.String(ErrorType(result.Err)))
+	}
+
+	i.requestDuration.Record(ctx, result.Duration.Seconds(), metric.WithAttributes(attrs...))
+	if result.UploadBytes > 0 {
+		i.uploadedBytes.Add(ctx, result.UploadBytes, metric.WithAttributes(attrs[:2]...))
+	}
+	if result.Err != nil {
+		i.requestErrors.Add(ctx, 1, metric.WithAttributes(attrs...))
+	}
+
+	span.SetAttributes(AttrRetryCount.Int(result.Retries))
+	if result.UploadBytes > 0 {
+		span.SetAttributes(AttrUploadBytes.Int64(result.UploadBytes))
+	}
+	if result.StatusCode > 0 {
+		span.SetAttributes(AttrStatusCode.Int(result.StatusCode))
+	}
+	if result.Err != nil {
+		span.SetAttributes(AttrErrorType.String(ErrorType(result.Err)))
+		span.RecordError(result.Err)
+		span.SetStatus(codes.Error, result.Err.Error())
+	}
+	span.End()
+}
+
+// RecordOutputTokens adds the output tokens reported in a response's Usage field.
+func (i *Instrumentation) RecordOutputTokens(ctx context.Context, operation string, tokens int) {
+	if tokens <= 0 {
+		return
+	}
+	i.outputTokens.Add(ctx, int64(tokens), metric.WithAttributes(AttrOperation.String(operation)))
+}
+
+// EndSpan records err on span, if any, and ends it.
+func EndSpan(span trace.Span, err error) {
+	if err != nil {
+		span.SetAttributes(AttrErrorType.String(ErrorType(err)))
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+// ErrorType returns the name of the typed SDK error wrapped by err, used as the
+// error.type attribute. Untyped errors are reported as "other".
+func ErrorType(err error) string {
+	switch {
+	case stderrors.Is(err, context.Canceled):
+		return "canceled"
+	case stderrors.Is(err, context.DeadlineExceeded):
+		return "deadline_exceeded"
+	case as[*errors.ValidationError](err):
+		return "ValidationError"
+	case as[*errors.BadRequestError](err):
+		return "BadRequestError"
+	case as[*errors.UnauthorizedError](err):
+		return "UnauthorizedError"
+	case as[*errors.ForbiddenError](err):
+		return "ForbiddenError"
+	case as[*errors.NotFoundError](err):
+		return "NotFoundError"
+	case as[*errors.ConflictError](err):
+		return "ConflictError"
+	case as[*errors.PayloadTooLargeError](err):
+		return "PayloadTooLargeError"
+	case as[*errors.UnprocessableEntityError](err):
+		return "UnprocessableEntityError"
+	case as[*errors.TooManyRequestsError](err):
+		return "TooManyRequestsError"
+	case as[*errors.InternalServerError](err):
+		return "InternalServerError"
+	case as[*errors.BadGatewayError](err):
+		return "BadGatewayError"
+	case as[*errors.ServiceUnavailableError](err):
+		return "ServiceUnavailableError"
+	case as[*errors.GatewayTimeoutError](err):
+		return "GatewayTimeoutError"
+	case as[*errors.TimeoutError](err):
+		return "TimeoutError"
+	case as[*errors.RequestError](err):
+		return "RequestError"
+	case as[*errors.APIError](err):
+		return "APIError"
+	default:
+		return "other"
+	}
+}
+
+func as[T error](err error) bool {
+	var target T
+	return stderrors.As(err, &target)
+}
+
+// ExternalEndpoint is the endpoint recorded for requests sent outside the API base URL,
+// such as uploads to pre-signed storage URLs.
+const ExternalEndpoint = "{external_url}"
+
+// Endpoint returns a low-cardinality route for an API path by replacing resource
+// identifiers with placeholders, e.g. "/indexes/{index_id}/videos/{video_id}".
+// It also returns the index and video IDs found in the path.
+func Endpoint(path string) (endpoint, indexID, videoID string) {
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	// Drop the version prefix of the base URL, e.g. "v1.3".
+	if len(segments) > 0 && strings.HasPrefix(segments[0], "v") && strings.Contains(segments[0], ".") {
+		segments = segments[1:]
+	}
+	for n := 1; n < len(segments); n++ {
+		switch segments[n-1] {
+		case "indexes":
+			indexID = segments[n]
+			segments[n] = "{index_id}"
+		case "videos":
+			videoID = segments[n]
+			segments[n] = "{video_id}"
+		case "tasks":
+			segments[n] = "{task_id}"
+		case "search":
+			segments[n] = "{page_token}"
+		default:
+			continue
+		}
+		n++
+	}
+	return "/" + strings.Join(segments, "/"), indexID, videoID
+}
+
+type resourceKey struct{}
+
+type resources struct {
+	indexID string
+	videoID string
+}
+
+// WithIndexID annotates ctx so that spans for API calls made with it carry the index ID.
+// Use it for requests whose index ID travels in the body rather than the path.
+func WithIndexID(ctx context.Context, indexID string) context.Context {
+	if indexID == "" {
+		return ctx
+	}
+	r, _ := ctx.Value(resourceKey{}).(resources)
+	r.indexID = indexID
+	return context.WithValue(ctx, resourceKey{}, r)
+}
+
+// WithVideoID annotates ctx so that spans for API calls made with it carry the video ID.
+func WithVideoID(ctx context.Context, videoID string) context.Context {
+	if videoID == "" {
+		return ctx
+	}
+	r, _ := ctx.Value(resourceKey{}).(resources)
+	r.videoID = videoID
+	return context.WithValue(ctx, resourceKey{}, r)
+}
+
+func resourceAttributes(ctx context.Context) []attribute.KeyValue {
+	r, ok := ctx.Value(resourceKey{}).(resources)
+	if !ok {
+		return nil
+	}
+	var attrs []attribute.KeyValue
+	if r.indexID != "" {
+		attrs = append(attrs, AttrIndexID.String(r.indexID))
+	}
+	if r.videoID != "" {
+		attrs = append(attrs, AttrVideoID.String(r.videoID))
+	}
+	return attrs
+}
@@ -46,7 +46,7 @@ func NewAnalyzeWrapper(service *services.AnalyzeService) *AnalyzeWrapper {
 func (aw *AnalyzeWrapper) Analyze(ctx context.Context, request *models.AnalyzeRequest) (*models.AnalyzeResponse, error) {
 	result, err := aw.service.Analyze(ctx, request)
 	if err != nil {
-		return nil, errors.NewServiceError("Analyze", "video analysis failed: "+err.Error())
+		return nil, errors.WrapServiceError("Analyze", "video analysis failed", err)
 	}
 
 	return result, nil
@@ -87,7 +87,7 @@ func (aw *AnalyzeWrapper) Analyze(ctx context.Context, request *models.AnalyzeRe
 func (aw *AnalyzeWrapper) AnalyzeStream(ctx context.Context, request *models.AnalyzeRequest, callback func(*models.AnalyzeStreamResponse) error) error {
 	err := aw.service.AnalyzeStream(ctx, request, callback)
 	if err != nil {
-		return errors.NewServiceError("Analyze", "streaming video analysis failed: "+err.Error())
+		return errors.WrapServiceError("Analyze", "streaming video analysis failed", err)
 	}
 
 	return nil
@@ -125,7 +125,7 @@ func (aw *AnalyzeWrapper) AnalyzeStream(ctx context.Context, request *models.Ana
 func (aw *AnalyzeWrapper) GenerateSummary(ctx context.Context, request *models.GenerateSummaryRequest) (*models.GenerateSummaryResponse, error) {
 	result, err := aw.service.GenerateSummary(ctx, request)
 	if err != nil {
-		return nil, errors.NewServiceError("Analyze", "video summary generation failed: "+err.Error())
+		return nil, errors.WrapServiceError("Analyze", "video summary generation failed", err)
 	}
 
 	return result, nil
@@ -161,7 +161,7 @@ func (aw *AnalyzeWrapper) GenerateSummary(ctx context.Context, request *models.G
 func (aw *AnalyzeWrapper) GenerateGist(ctx context.Context, request *models.GenerateGistRequest) (*models.GenerateGistResponse, error) {
 	result, err := aw.service.GenerateGist(ctx, request)
 	if err != nil {
-		return nil, errors.NewServiceError("Analyze", "video gist generation failed: "+err.Error())
+		return nil, errors.WrapServiceError("Analyze", "video gist generation failed", err)
 	}
 
 	return result, nil
@@ -0,0 +1,104 @@
+package wrappers
+
+import (
+	"context"
+	stderrors "errors"
+	"fmt"
+	"sync"
+)
+
+// BulkMode selects how bulk operations handle items that fail.
+type BulkMode int
+
+const (
+	// BulkBestEffort attempts every item and reports failures per item. This is the default.
+	BulkBestEffort BulkMode = iota
+	// BulkFailFast stops starting new items after the first failure and cancels the
+	// items in flight.
+	BulkFailFast
+)
+
+// ErrBulkAborted is reported for items that were skipped or cancelled because an
+// earlier item failed in BulkFailFast mode.
+var ErrBulkAborted = stderrors.New("bulk operation aborted after an earlier failure")
+
+// bulkRun describes a bulk operation over n items.
+type bulkRun struct {
+	// workers is the number of items processed concurrently; zero means 4.
+	workers int
+	mode    BulkMode
+	// source describes item i in errors.
+	source func(i int) string
+	// do processes item i.
+	do func(ctx context.Context, i int) error
+	// onFailure is called for each item that fails while the batch is running.
+	onFailure func(i int, err error)
+}
+
+// run processes n items with a pool of workers. It returns the error of each item,
+// nil for those that succeeded, along with the failures combined with errors.Join,
+// each prefixed with its source, plus the context error if ctx was cancelled.
+// Items that never started, or were interrupted when the batch stopped, carry the
+// reason the batch stopped and are left out of the combined error.
+func (b *bulkRun) run(ctx context.Context, n int) ([]error, error) {
+	workers := b.workers
+	if workers <= 0 {
+		workers = 4
+	}
+	workers = min(workers, n)
+
+	itemErrs := make([]error, n)
+	started := make([]bool, n)
+
+	batchCtx, cancel := context.WithCancelCause(ctx)
+	defer cancel(nil)
+
+	var wg sync.WaitGroup
+	jobs := make(chan int)
+	for range workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				err := b.do(batchCtx, i)
+				if err == nil {
+					continue
+				}
+				itemErrs[i] = err
+				if batchCtx.Err() == nil {
+					b.onFailure(i, err)
+					if b.mode == BulkFailFast {
+						cancel(ErrBulkAborted)
+					}
+				}
+			}
+		}()
+	}
+
+feed:
+	for i := range n {
+		select {
+		case jobs <- i:
+			started[i] = true
+		case <-batchCtx.Done():
+			break feed
+		}
+	}
+	close(jobs)
+	wg.Wait()
+
+	var errs []error
+	for i, err := range itemErrs {
+		if !started[i] || (err != nil && batchCtx.Err() != nil && isContextError(err)) {
+			itemErrs[i] = context.Cause(batchCtx)
+			continue
+		}
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", b.source(i), err))
+		}
+	}
+	if err := ctx.Err(); err != nil {
+		errs = append(errs, err)
+	}
+	return itemErrs, stderrors.Join(errs...)
+}
@@ -40,6 +40,15 @@ type EmbedWrapperRequest struct {
 	// Image embedding options (use one of: ImageFile or ImageURL)
 	ImageFile string `json:"image_file"` // Local image file path
 	ImageURL  string `json:"image_url"`  // Publicly accessible image URL
+
+	// Media sources for content held in memory or streamed from a reader.
+	// Each takes precedence over the corresponding file path.
+	VideoSource *models.MediaSource `json:"-"`
+	AudioSource *models.MediaSource `json:"-"`
+	ImageSource *models.MediaSource `json:"-"`
+
+	// OnProgress is called periodically while a local file is being uploaded (optional)
+	OnProgress models.ProgressFunc `json:"-"`
 }
 
 // Create generates embeddings for any supported media type based on the request content.
@@ -84,21 +93,25 @@ type EmbedWrapperRequest struct {
 func (ew *EmbedWrapper) Create(ctx context.Context, request *EmbedWrapperRequest) (*models.EmbedResponse, error) {
 	// Convert to the base service request format
 	baseRequest := &models.EmbedRequest{
-		ModelName: request.ModelName,
-		VideoID:   request.VideoID,
-		VideoFile: request.VideoFile,
-		VideoURL:  request.VideoURL,
-		Text:      request.Text,
-		ImageURL:  request.ImageURL,
-		ImageFile: request.ImageFile,
-		AudioURL:  request.AudioURL,
-		AudioFile: request.AudioFile,
+		ModelName:   request.ModelName,
+		VideoID:     request.VideoID,
+		VideoFile:   request.VideoFile,
+		VideoURL:    request.VideoURL,
+		Text:        request.Text,
+		ImageURL:    request.ImageURL,
+		ImageFile:   request.ImageFile,
+		AudioURL:    request.AudioURL,
+		AudioFile:   request.AudioFile,
+		VideoSource: request.VideoSource,
+		AudioSource: request.AudioSource,
+		ImageSource: request.ImageSource,
+		OnProgress:  request.OnProgress,
 	}
 
 	// Use the existing Create method from the base service
 	result, err := ew.service.Create(ctx, baseRequest)
 	if err != nil {
-		return nil, errors.NewServiceError("Embed", "embedding creation failed: "+err.Error())
+		return nil, errors.WrapServiceError("Embed", "embedding creation failed", err)
 	}
 
 	return result, nil
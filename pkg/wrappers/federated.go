@@ -0,0 +1,282 @@
+package wrappers
+
+import (
+	"cmp"
+	"context"
+	"io"
+	"log/slog"
+	"slices"
+
+	"github.com/favourthemaster/twelvelabs-go-sdk/pkg/errors"
+	"github.com/favourthemaster/twelvelabs-go-sdk/pkg/models"
+	"github.com/favourthemaster/twelvelabs-go-sdk/pkg/pagination"
+)
+
+// MergeStrategy selects how FederatedSearch ranks the results of several indexes
+// against each other. Scores are not comparable between indexes, so they are
+// turned into a merged score first.
+type MergeStrategy int
+
+const (
+	// MergeNormalizedScore rescales the scores of each index to the range 0 to 1,
+	// the best result of an index scoring 1. This is the default.
+	MergeNormalizedScore MergeStrategy = iota
+	// MergeReciprocalRank scores results by reciprocal rank fusion, 1/(k+rank),
+	// using only their rank within their index.
+	MergeReciprocalRank
+)
+
+// defaultRankConstant is the k of reciprocal rank fusion commonly used.
+const defaultRankConstant = 60
+
+// FederatedSearchRequest represents a search run across several indexes.
+type FederatedSearchRequest struct {
+	// IndexIDs are the indexes to search
+	IndexIDs []string
+	// Request is the search run on every index. Its IndexID and PageToken are
+	// ignored. A QueryMediaSource reader is read into memory once when several
+	// indexes are searched, so prefer QueryMediaURL or QueryMediaFile for large media.
+	Request *models.SearchRequest
+	// MaxResultsPerIndex is the number of results fetched from each index, following
+	// the pages of its results (optional, defaults to the first page only)
+	MaxResultsPerIndex int
+	// Concurrency is the number of indexes searched in parallel (optional, defaults to 4)
+	Concurrency int
+	// Merge selects how results are ranked across indexes (optional, defaults to MergeNormalizedScore)
+	Merge MergeStrategy
+	// RankConstant is the k of MergeReciprocalRank (optional, defaults to 60)
+	RankConstant float64
+}
+
+// FederatedResult is a clip found by FederatedSearch.
+type FederatedResult struct {
+	models.SearchResult
+	// IndexID is the index the clip was found in.
+	IndexID string
+	// MergedScore ranks the clip among the results of all indexes, see MergeStrategy.
+	MergedScore float64
+}
+
+// FederatedSearchResponse holds the merged results of a search across several indexes.
+type FederatedSearchResponse struct {
+	// Results are the clips of all indexes, best first. Overlapping clips of the
+	// same video are reported once.
+	Results []FederatedResult
+	// Errors holds the error of each index that could not be searched.
+	Errors map[string]error
+}
+
+// FederatedSearch runs the same search on several indexes concurrently and merges
+// the results into a single ranking. Each result is tagged with its index.
+//
+// An index that fails does not fail the search: its error is reported in the
+// response's Errors and the results of the other indexes are returned.
+//
+// Returns:
+//   - The merged results and the errors of the indexes that failed
+//   - error if no index could be searched, combining the errors of every index
+//
+// Example:
+//
+//	response, err := client.Search.FederatedSearch(ctx, &wrappers.FederatedSearchRequest{
+//	    IndexIDs: []string{"index_eu", "index_us", "index_apac"},
+//	    Request: &models.SearchRequest{
+//	        QueryText:     "product launch keynote",
+//	        SearchOptions: []string{"visual", "audio"},
+//	    },
+//	    MaxResultsPerIndex: 50,
+//	    Merge:              wrappers.MergeReciprocalRank,
+//	})
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	for indexID, err := range response.Errors {
+//	    log.Printf("index %s skipped: %v", indexID, err)
+//	}
+//	page := response.Page(1, 20)
+//	for _, result := range page.Items {
+//	    fmt.Printf("%s %s %.1f-%.1fs\n", result.IndexID, result.VideoID, result.Start, result.End)
+//	}
+func (sw *SearchWrapper) FederatedSearch(ctx context.Context, request *FederatedSearchRequest) (*FederatedSearchResponse, error) {
+	if len(request.IndexIDs) == 0 {
+		return nil, errors.NewValidationError("at least one index ID must be provided")
+	}
+	if request.Request == nil {
+		return nil, errors.NewValidationError("Request must be provided")
+	}
+	request, err := bufferQueryMedia(request)
+	if err != nil {
+		return nil, err
+	}
+
+	found := make([][]models.SearchResult, len(request.IndexIDs))
+	run := &bulkRun{
+		workers: request.Concurrency,
+		source:  func(i int) string { return request.IndexIDs[i] },
+		do: func(ctx context.Context, i int) error {
+			results, err := sw.searchIndex(ctx, request, request.IndexIDs[i])
+			found[i] = results
+			return err
+		},
+		onFailure: func(i int, err error) {
+			sw.service.Client.Logger().Warn("failed to search index",
+				slog.String("index_id", request.IndexIDs[i]), slog.Any("error", err))
+		},
+	}
+	errs, runErr := run.run(ctx, len(request.IndexIDs))
+
+	response := &FederatedSearchResponse{Errors: make(map[string]error)}
+	var results []FederatedResult
+	for i, indexID := range request.IndexIDs {
+		if errs[i] != nil {
+			response.Errors[indexID] = errs[i]
+			continue
+		}
+		results = append(results, mergedScores(indexID, found[i], request)...)
+	}
+	response.Results = dedupeResults(results, request.Merge == MergeReciprocalRank)
+
+	if len(response.Errors) == len(request.IndexIDs) {
+		return response, errors.WrapServiceError("Search", "federated search failed on every index", runErr)
+	}
+	if err := ctx.Err(); err != nil {
+		return response, err
+	}
+	return response, nil
+}
+
+// bufferQueryMedia returns request with its QueryMediaSource reader read into
+// memory when several indexes are searched, so that each search reads its own
+// copy instead of sharing the reader. The caller's request is not modified.
+func bufferQueryMedia(request *FederatedSearchRequest) (*FederatedSearchRequest, error) {
+	source := request.Request.QueryMediaSource
+	if source == nil || source.Path != "" || source.Data != nil || source.Reader == nil || len(request.IndexIDs) < 2 {
+		return request, nil
+	}
+	reader := source.Reader
+	if source.Size > 0 {
+		reader = io.LimitReader(reader, source.Size)
+	}
+	data, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, errors.WrapServiceError("Search", "failed to read query media", err)
+	}
+
+	buffered := *source
+	buffered.Reader = nil
+	buffered.Data = data
+	buffered.Size = int64(len(data))
+	search := *request.Request
+	search.QueryMediaSource = &buffered
+	federated := *request
+	federated.Request = &search
+	return &federated, nil
+}
+
+// searchIndex runs the search on a single index and follows its pages until
+// MaxResultsPerIndex results have been collected.
+func (sw *SearchWrapper) searchIndex(ctx context.Context, request *FederatedSearchRequest, indexID string) ([]models.SearchResult, error) {
+	search := *request.Request
+	search.IndexID = indexID
+	search.PageToken = ""
+
+	response, err := sw.service.Search(ctx, &search)
+	if err != nil {
+		return nil, err
+	}
+	results := response.Results()
+	for len(results) < request.MaxResultsPerIndex && response.PageInfo != nil && response.PageInfo.NextPageToken != "" {
+		response, err = sw.service.Retrieve(ctx, response.PageInfo.NextPageToken)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, response.Results()...)
+	}
+	if request.MaxResultsPerIndex > 0 && len(results) > request.MaxResultsPerIndex {
+		results = results[:request.MaxResultsPerIndex]
+	}
+	return results, nil
+}
+
+// mergedScores tags the results of an index and gives them their merged score.
+// The results are ranked by the index in the order given.
+func mergedScores(indexID string, results []models.SearchResult, request *FederatedSearchRequest) []FederatedResult {
+	merged := make([]FederatedResult, len(results))
+	if len(results) == 0 {
+		return merged
+	}
+
+	low, high := results[0].Score, results[0].Score
+	for _, result := range results {
+		low, high = min(low, result.Score), max(high, result.Score)
+	}
+	k := cmp.Or(request.RankConstant, defaultRankConstant)
+
+	for i, result := range results {
+		merged[i] = FederatedResult{SearchResult: result, IndexID: indexID}
+		switch {
+		case request.Merge == MergeReciprocalRank:
+			merged[i].MergedScore = 1 / (k + float64(i+1))
+		case high > low:
+			merged[i].MergedScore = (result.Score - low) / (high - low)
+		default:
+			merged[i].MergedScore = 1
+		}
+	}
+	return merged
+}
+
+// dedupeResults ranks the results by merged score and drops clips overlapping a
+// better clip of the same video. With fuse set, as for reciprocal rank fusion, the
+// score of a dropped clip is added to the clip it overlaps.
+func dedupeResults(results []FederatedResult, fuse bool) []FederatedResult {
+	byScore := func(a, b FederatedResult) int {
+		return cmp.Compare(b.MergedScore, a.MergedScore)
+	}
+	slices.SortStableFunc(results, byScore)
+
+	kept := make([]FederatedResult, 0, len(results))
+	byVideo := make(map[string][]int)
+	for _, result := range results {
+		duplicate := -1
+		for _, i := range byVideo[result.VideoID] {
+			if kept[i].Start < result.End && result.Start < kept[i].End {
+				duplicate = i
+				break
+			}
+		}
+		if duplicate < 0 {
+			byVideo[result.VideoID] = append(byVideo[result.VideoID], len(kept))
+			kept = append(kept, result)
+			continue
+		}
+		if fuse {
+			kept[duplicate].MergedScore += result.MergedScore
+		}
+	}
+	if fuse {
+		slices.SortStableFunc(kept, byScore)
+	}
+	return kept
+}
+
+// Page returns page number of the merged results, with pageLimit results per page
+// (defaulting to 10). Pages start at 1; the page's Next, Prev and Pager methods
+// move through the merged results without further requests.
+func (r *FederatedSearchResponse) Page(number, pageLimit int) *pagination.Page[FederatedResult] {
+	if pageLimit <= 0 {
+		pageLimit = 10
+	}
+	totalPages := max((len(r.Results)+pageLimit-1)/pageLimit, 1)
+
+	var fetch pagination.FetchFunc[FederatedResult]
+	fetch = func(_ context.Context, cursor pagination.Cursor) (*pagination.Page[FederatedResult], error) {
+		number := min(max(cursor.Page, 1), totalPages)
+		start := min((number-1)*pageLimit, len(r.Results))
+		end := min(start+pageLimit, len(r.Results))
+		info := &models.PageInfo{Page: number, TotalPage: totalPages, TotalResults: len(r.Results)}
+		return pagination.New(r.Results[start:end], info, pagination.Cursor{Page: number}, fetch), nil
+	}
+	page, _ := fetch(context.Background(), pagination.Cursor{Page: number})
+	return page
+}
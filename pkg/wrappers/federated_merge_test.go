@@ -0,0 +1,156 @@
+package wrappers
+
+import (
+	"math"
+	"testing"
+
+	"github.com/favourthemaster/twelvelabs-go-sdk/pkg/models"
+)
+
+func TestMergedScores(t *testing.T) {
+	tests := []struct {
+		name    string
+		scores  []float64
+		request FederatedSearchRequest
+		want    []float64
+	}{
+		{
+			name:   "normalized",
+			scores: []float64{90, 70, 50},
+			want:   []float64{1, 0.5, 0},
+		},
+		{
+			name:   "normalized with equal scores",
+			scores: []float64{80, 80},
+			want:   []float64{1, 1},
+		},
+		{
+			name:   "normalized single result",
+			scores: []float64{42},
+			want:   []float64{1},
+		},
+		{
+			name:    "reciprocal rank",
+			scores:  []float64{90, 10},
+			request: FederatedSearchRequest{Merge: MergeReciprocalRank},
+			want:    []float64{1.0 / 61, 1.0 / 62},
+		},
+		{
+			name:    "reciprocal rank with custom constant",
+			scores:  []float64{90, 10, 5},
+			request: FederatedSearchRequest{Merge: MergeReciprocalRank, RankConstant: 10},
+			want:    []float64{1.0 / 11, 1.0 / 12, 1.0 / 13},
+		},
+		{
+			name: "no results",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			results := make([]models.SearchResult, len(tt.scores))
+			for i, score := range tt.scores {
+				results[i] = models.SearchResult{VideoID: "video-1", Score: score, Start: float64(10 * i), End: float64(10*i + 5)}
+			}
+			merged := mergedScores("index-1", results, &tt.request)
+			if len(merged) != len(tt.want) {
+				t.Fatalf("got %d results, want %d", len(merged), len(tt.want))
+			}
+			for i, result := range merged {
+				if result.IndexID != "index-1" {
+					t.Errorf("result %d: index = %q, want index-1", i, result.IndexID)
+				}
+				if result.Score != tt.scores[i] {
+					t.Errorf("result %d: score = %v, want the original %v", i, result.Score, tt.scores[i])
+				}
+				if !almostEqual(result.MergedScore, tt.want[i]) {
+					t.Errorf("result %d: merged score = %v, want %v", i, result.MergedScore, tt.want[i])
+				}
+			}
+		})
+	}
+}
+
+func TestDedupeResults(t *testing.T) {
+	clip := func(indexID, videoID string, start, end, score float64) FederatedResult {
+		return FederatedResult{
+			SearchResult: models.SearchResult{VideoID: videoID, Start: start, End: end},
+			IndexID:      indexID,
+			MergedScore:  score,
+		}
+	}
+	tests := []struct {
+		name    string
+		results []FederatedResult
+		fuse    bool
+		want    []FederatedResult
+	}{
+		{
+			name: "sorted by merged score",
+			results: []FederatedResult{
+				clip("index-1", "video-1", 0, 10, 0.2),
+				clip("index-2", "video-2", 0, 10, 0.9),
+			},
+			want: []FederatedResult{
+				clip("index-2", "video-2", 0, 10, 0.9),
+				clip("index-1", "video-1", 0, 10, 0.2),
+			},
+		},
+		{
+			name: "overlapping clip of the same video dropped",
+			results: []FederatedResult{
+				clip("index-1", "video-1", 5, 15, 0.5),
+				clip("index-2", "video-1", 0, 10, 0.9),
+				clip("index-2", "video-2", 5, 15, 0.4),
+			},
+			want: []FederatedResult{
+				clip("index-2", "video-1", 0, 10, 0.9),
+				clip("index-2", "video-2", 5, 15, 0.4),
+			},
+		},
+		{
+			name: "adjacent clips kept",
+			results: []FederatedResult{
+				clip("index-1", "video-1", 0, 10, 0.9),
+				clip("index-2", "video-1", 10, 20, 0.8),
+			},
+			want: []FederatedResult{
+				clip("index-1", "video-1", 0, 10, 0.9),
+				clip("index-2", "video-1", 10, 20, 0.8),
+			},
+		},
+		{
+			name: "overlapping score fused",
+			results: []FederatedResult{
+				clip("index-1", "video-2", 0, 5, 0.5),
+				clip("index-1", "video-1", 0, 10, 0.4),
+				clip("index-2", "video-1", 5, 15, 0.3),
+			},
+			fuse: true,
+			want: []FederatedResult{
+				clip("index-1", "video-1", 0, 10, 0.7),
+				clip("index-1", "video-2", 0, 5, 0.5),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := dedupeResults(tt.results, tt.fuse)
+			if len(got) != len(tt.want) {
+				t.Fatalf("got %d results, want %d: %+v", len(got), len(tt.want), got)
+			}
+			for i := range got {
+				want := tt.want[i]
+				if got[i].IndexID != want.IndexID || got[i].VideoID != want.VideoID || got[i].Start != want.Start ||
+					!almostEqual(got[i].MergedScore, want.MergedScore) {
+					t.Errorf("result %d = %s/%s %v-%v %.3f, want %s/%s %v-%v %.3f", i,
+						got[i].IndexID, got[i].VideoID, got[i].Start, got[i].End, got[i].MergedScore,
+						want.IndexID, want.VideoID, want.Start, want.End, want.MergedScore)
+				}
+			}
+		})
+	}
+}
+
+func almostEqual(a, b float64) bool {
+	return math.Abs(a-b) < 1e-9
+}
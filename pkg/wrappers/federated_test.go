@@ -0,0 +1,163 @@
+package wrappers_test
+
+import (
+	"context"
+	stderrors "errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/favourthemaster/twelvelabs-go-sdk/pkg/client"
+	"github.com/favourthemaster/twelvelabs-go-sdk/pkg/errors"
+	"github.com/favourthemaster/twelvelabs-go-sdk/pkg/models"
+	"github.com/favourthemaster/twelvelabs-go-sdk/pkg/wrappers"
+)
+
+func TestFederatedSearchSendsQueryReaderToEveryIndex(t *testing.T) {
+	var mu sync.Mutex
+	received := make(map[string]string)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseMultipartForm(1 << 20); err != nil {
+			t.Errorf("parse form: %v", err)
+			return
+		}
+		file, _, err := r.FormFile("query_media_file")
+		if err != nil {
+			t.Errorf("query_media_file: %v", err)
+			return
+		}
+		defer file.Close()
+		content, _ := io.ReadAll(file)
+		mu.Lock()
+		received[r.FormValue("index_id")] = string(content)
+		mu.Unlock()
+		writeJSON(w, map[string]any{"data": []any{}})
+	}))
+	defer server.Close()
+
+	c := client.NewClient(&client.Options{BaseURL: server.URL, APIKey: "test-key"})
+	search := wrappers.NewSearchWrapper(c.Search)
+
+	// MultiReader hides the Seek method, so the reader can only be read once.
+	query := io.MultiReader(strings.NewReader("query image"))
+	indexIDs := []string{"index-1", "index-2", "index-3"}
+	response, err := search.FederatedSearch(context.Background(), &wrappers.FederatedSearchRequest{
+		IndexIDs: indexIDs,
+		Request: &models.SearchRequest{
+			QueryMediaType:   "image",
+			QueryMediaSource: models.MediaFromReader(query, "query.jpg", int64(len("query image"))),
+			SearchOptions:    []string{"visual"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("FederatedSearch: %v", err)
+	}
+	if len(response.Errors) > 0 {
+		t.Fatalf("index errors: %v", response.Errors)
+	}
+	for _, indexID := range indexIDs {
+		if received[indexID] != "query image" {
+			t.Errorf("%s received query media %q, want %q", indexID, received[indexID], "query image")
+		}
+	}
+}
+
+func TestFederatedSearchReportsFailedIndexes(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseMultipartForm(1 << 20); err != nil {
+			t.Errorf("parse form: %v", err)
+			return
+		}
+		indexID := r.FormValue("index_id")
+		if strings.HasPrefix(indexID, "broken") {
+			w.WriteHeader(http.StatusNotFound)
+			writeJSON(w, map[string]string{"code": "index_not_found", "message": "index not found"})
+			return
+		}
+		writeJSON(w, models.SearchResponse{Data: []models.SearchResult{
+			{VideoID: indexID + "-video", Score: 80, Start: 0, End: 10},
+		}})
+	}))
+	defer server.Close()
+
+	c := client.NewClient(&client.Options{BaseURL: server.URL, APIKey: "test-key"})
+	search := wrappers.NewSearchWrapper(c.Search)
+	request := &models.SearchRequest{QueryText: "goal", SearchOptions: []string{"visual"}}
+
+	response, err := search.FederatedSearch(context.Background(), &wrappers.FederatedSearchRequest{
+		IndexIDs: []string{"index-1", "broken-1", "index-2"},
+		Request:  request,
+	})
+	if err != nil {
+		t.Fatalf("FederatedSearch with one failing index: %v", err)
+	}
+	if len(response.Errors) != 1 || !stderrors.Is(response.Errors["broken-1"], errors.ErrNotFound) {
+		t.Errorf("Errors = %v, want a not found error for broken-1", response.Errors)
+	}
+	if len(response.Results) != 2 {
+		t.Fatalf("got %d results, want one per working index", len(response.Results))
+	}
+	for _, result := range response.Results {
+		if result.VideoID != result.IndexID+"-video" {
+			t.Errorf("result %s tagged with index %s", result.VideoID, result.IndexID)
+		}
+	}
+
+	response, err = search.FederatedSearch(context.Background(), &wrappers.FederatedSearchRequest{
+		IndexIDs: []string{"broken-1", "broken-2"},
+		Request:  request,
+	})
+	if err == nil {
+		t.Fatal("FederatedSearch succeeded although every index failed")
+	}
+	if len(response.Errors) != 2 {
+		t.Errorf("Errors = %v, want both indexes", response.Errors)
+	}
+}
+
+func TestFederatedSearchResponsePage(t *testing.T) {
+	response := &wrappers.FederatedSearchResponse{}
+	for i := range 5 {
+		response.Results = append(response.Results, wrappers.FederatedResult{
+			SearchResult: models.SearchResult{VideoID: fmt.Sprintf("video-%d", i)},
+		})
+	}
+
+	tests := []struct {
+		name       string
+		response   *wrappers.FederatedSearchResponse
+		number     int
+		limit      int
+		wantPage   int
+		wantVideos []string
+		wantPages  int
+	}{
+		{name: "first page", response: response, number: 1, limit: 2, wantPage: 1, wantVideos: []string{"video-0", "video-1"}, wantPages: 3},
+		{name: "last partial page", response: response, number: 3, limit: 2, wantPage: 3, wantVideos: []string{"video-4"}, wantPages: 3},
+		{name: "before the first page", response: response, number: 0, limit: 2, wantPage: 1, wantVideos: []string{"video-0", "video-1"}, wantPages: 3},
+		{name: "past the last page", response: response, number: 9, limit: 2, wantPage: 3, wantVideos: []string{"video-4"}, wantPages: 3},
+		{name: "default limit", response: response, number: 1, wantPage: 1, wantVideos: []string{"video-0", "video-1", "video-2", "video-3", "video-4"}, wantPages: 1},
+		{name: "no results", response: &wrappers.FederatedSearchResponse{}, number: 2, limit: 2, wantPage: 1, wantPages: 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			page := tt.response.Page(tt.number, tt.limit)
+			var videos []string
+			for _, result := range page.Items {
+				videos = append(videos, result.VideoID)
+			}
+			if page.Cursor.Page != tt.wantPage || page.TotalPages != tt.wantPages || !slices.Equal(videos, tt.wantVideos) {
+				t.Errorf("Page(%d, %d) = page %d of %d %v, want page %d of %d %v", tt.number, tt.limit,
+					page.Cursor.Page, page.TotalPages, videos, tt.wantPage, tt.wantPages, tt.wantVideos)
+			}
+			if page.TotalResults != len(tt.response.Results) {
+				t.Errorf("TotalResults = %d, want %d", page.TotalResults, len(tt.response.Results))
+			}
+		})
+	}
+}
@@ -2,8 +2,10 @@ package wrappers
 
 import (
 	"context"
+	"iter"
 
 	"github.com/favourthemaster/twelvelabs-go-sdk/pkg/models"
+	"github.com/favourthemaster/twelvelabs-go-sdk/pkg/pagination"
 	"github.com/favourthemaster/twelvelabs-go-sdk/pkg/services"
 )
 
@@ -26,9 +28,30 @@ func (iw *IndexesWrapper) Create(ctx context.Context, request *models.IndexCreat
 	return iw.service.Create(ctx, request)
 }
 
-// List retrieves all indexes with optional filters
-func (iw *IndexesWrapper) List(ctx context.Context, filters map[string]string) ([]models.Index, error) {
-	return iw.service.List(ctx, filters)
+// List retrieves a page of indexes with optional filters
+func (iw *IndexesWrapper) List(ctx context.Context, opts *models.IndexListOptions) ([]models.Index, error) {
+	return iw.service.List(ctx, opts)
+}
+
+// Iterate returns an iterator over every index matching opts, fetching pages lazily.
+// The iteration stops after yielding an error.
+func (iw *IndexesWrapper) Iterate(ctx context.Context, opts *models.IndexListOptions) iter.Seq2[models.Index, error] {
+	return iw.service.Iterate(ctx, opts)
+}
+
+// All retrieves every index matching opts, following the pages to the end
+func (iw *IndexesWrapper) All(ctx context.Context, opts *models.IndexListOptions) ([]models.Index, error) {
+	return iw.service.All(ctx, opts)
+}
+
+// ListPage retrieves a single page of indexes with its totals and cursors
+func (iw *IndexesWrapper) ListPage(ctx context.Context, opts *models.IndexListOptions) (*pagination.Page[models.Index], error) {
+	return iw.service.ListPage(ctx, opts)
+}
+
+// Pager returns a pager over the indexes matching opts
+func (iw *IndexesWrapper) Pager(opts *models.IndexListOptions) *pagination.Pager[models.Index] {
+	return iw.service.Pager(opts)
 }
 
 // Retrieve gets a specific index by ID
@@ -58,9 +81,55 @@ func NewIndexesVideosWrapper(service *services.IndexesService) *IndexesVideosWra
 	}
 }
 
-// List retrieves videos in an index with optional filters
-func (ivw *IndexesVideosWrapper) List(ctx context.Context, indexID string, filters map[string]string) ([]models.Video, error) {
-	return ivw.service.ListVideos(ctx, indexID, filters)
+// List retrieves a page of videos in an index with optional filters
+func (ivw *IndexesVideosWrapper) List(ctx context.Context, indexID string, opts *models.VideoListOptions) ([]models.Video, error) {
+	return ivw.service.ListVideos(ctx, indexID, opts)
+}
+
+// Iterate returns an iterator over every video in an index matching opts, fetching
+// pages lazily. The iteration stops after yielding an error.
+//
+// Example:
+//
+//	long := &models.VideoListOptions{
+//	    Duration:     &models.Range{Min: 600},
+//	    UserMetadata: map[string]string{"category": "sports"},
+//	}
+//	for video, err := range client.Indexes.Videos.Iterate(ctx, indexID, long) {
+//	    if err != nil {
+//	        log.Fatal(err)
+//	    }
+//	    fmt.Println(video.ID, video.Metadata.FileName)
+//	}
+func (ivw *IndexesVideosWrapper) Iterate(ctx context.Context, indexID string, opts *models.VideoListOptions) iter.Seq2[models.Video, error] {
+	return ivw.service.IterateVideos(ctx, indexID, opts)
+}
+
+// All retrieves every video in an index matching opts, following the pages to the end
+func (ivw *IndexesVideosWrapper) All(ctx context.Context, indexID string, opts *models.VideoListOptions) ([]models.Video, error) {
+	return ivw.service.AllVideos(ctx, indexID, opts)
+}
+
+// ListPage retrieves a single page of videos in an index with its totals and cursors
+func (ivw *IndexesVideosWrapper) ListPage(ctx context.Context, indexID string, opts *models.VideoListOptions) (*pagination.Page[models.Video], error) {
+	return ivw.service.ListVideosPage(ctx, indexID, opts)
+}
+
+// Pager returns a pager over the videos in an index matching opts. Set its Prefetch
+// field to fetch pages in the background while earlier ones are processed.
+//
+// Example:
+//
+//	pager := client.Indexes.Videos.Pager(indexID, &models.VideoListOptions{PageLimit: 50})
+//	pager.Prefetch = 2
+//	for video, err := range pager.Items(ctx) {
+//	    if err != nil {
+//	        log.Fatal(err)
+//	    }
+//	    process(video)
+//	}
+func (ivw *IndexesVideosWrapper) Pager(indexID string, opts *models.VideoListOptions) *pagination.Pager[models.Video] {
+	return ivw.service.VideosPager(indexID, opts)
 }
 
 // Retrieve gets a specific video in an index
@@ -0,0 +1,203 @@
+package wrappers
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"maps"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/favourthemaster/twelvelabs-go-sdk/pkg/models"
+)
+
+// manifestMetadataPrefix marks CSV columns that hold user metadata, e.g. "metadata.title".
+const manifestMetadataPrefix = "metadata."
+
+// LoadBulkManifest reads the task specifications listed in a CSV or JSONL manifest,
+// for use as CreateBulkRequest.Items. The format is chosen by the file extension:
+// ".csv" for CSV and ".jsonl" or ".ndjson" for JSON Lines. Relative video_file
+// paths are resolved against the directory of the manifest.
+//
+// See ReadCSVManifest and ReadJSONLManifest for the expected layouts.
+//
+// Example:
+//
+//	items, err := wrappers.LoadBulkManifest("./catalogue.jsonl")
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	results, err := client.Tasks.CreateBulk(ctx, &wrappers.CreateBulkRequest{
+//	    IndexID: "your_index_id",
+//	    Items:   items,
+//	})
+func LoadBulkManifest(path string) ([]BulkTaskItem, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	var items []BulkTaskItem
+	switch ext := strings.ToLower(filepath.Ext(path)); ext {
+	case ".csv":
+		items, err = ReadCSVManifest(file)
+	case ".jsonl", ".ndjson":
+		items, err = ReadJSONLManifest(file)
+	default:
+		return nil, fmt.Errorf("unsupported manifest format %q: use .csv, .jsonl or .ndjson", ext)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	dir := filepath.Dir(path)
+	for i := range items {
+		if videoFile := items[i].VideoFile; videoFile != "" && !filepath.IsAbs(videoFile) {
+			items[i].VideoFile = filepath.Join(dir, videoFile)
+		}
+	}
+	return items, nil
+}
+
+// ReadCSVManifest reads task specifications from CSV with a header row. Recognised
+// columns are the JSON names of models.TasksCreateRequest:
+//
+//	index_id, video_file, video_url, video_start_offset_sec, video_end_offset_sec,
+//	video_clip_length, video_embedding_scope, enable_video_stream, user_metadata
+//
+// video_embedding_scope lists scopes separated by "|" or ";", user_metadata holds a
+// JSON object, and each "metadata.<key>" column sets a single user metadata key.
+// Empty cells are left unset so that CreateBulkRequest.Defaults apply, and
+// enable_video_stream sets BulkTaskItem.EnableVideoStream. File paths are returned
+// as written.
+func ReadCSVManifest(r io.Reader) ([]BulkTaskItem, error) {
+	reader := csv.NewReader(r)
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err == io.EOF {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read manifest header: %w", err)
+	}
+	for i := range header {
+		header[i] = strings.TrimSpace(header[i])
+	}
+	header[0] = strings.TrimPrefix(header[0], "\ufeff") // byte order mark written by spreadsheet exports
+
+	var items []BulkTaskItem
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			return items, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read manifest: %w", err)
+		}
+		line, _ := reader.FieldPos(0)
+
+		var item BulkTaskItem
+		for i, value := range record {
+			value = strings.TrimSpace(value)
+			if value == "" {
+				continue
+			}
+			if err := setManifestField(&item, header[i], value); err != nil {
+				return nil, fmt.Errorf("manifest line %d, column %q: %w", line, header[i], err)
+			}
+		}
+		if err := validateManifestItem(&item.TasksCreateRequest); err != nil {
+			return nil, fmt.Errorf("manifest line %d: %w", line, err)
+		}
+		items = append(items, item)
+	}
+}
+
+// ReadJSONLManifest reads task specifications from JSON Lines, one JSON object per
+// line using the JSON names of models.TasksCreateRequest. Blank lines are skipped.
+// File paths are returned as written.
+func ReadJSONLManifest(r io.Reader) ([]BulkTaskItem, error) {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+
+	var items []BulkTaskItem
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" {
+			continue
+		}
+		var item BulkTaskItem
+		if err := json.Unmarshal([]byte(text), &item); err != nil {
+			return nil, fmt.Errorf("manifest line %d: %w", line, err)
+		}
+		if err := validateManifestItem(&item.TasksCreateRequest); err != nil {
+			return nil, fmt.Errorf("manifest line %d: %w", line, err)
+		}
+		items = append(items, item)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read manifest: %w", err)
+	}
+	return items, nil
+}
+
+// setManifestField sets the task option named column from a CSV cell.
+func setManifestField(item *BulkTaskItem, column, value string) error {
+	var err error
+	switch column {
+	case "index_id":
+		item.IndexID = value
+	case "video_file":
+		item.VideoFile = value
+	case "video_url":
+		item.VideoURL = value
+	case "video_start_offset_sec":
+		item.VideoStartOffsetSec, err = strconv.Atoi(value)
+	case "video_end_offset_sec":
+		item.VideoEndOffsetSec, err = strconv.Atoi(value)
+	case "video_clip_length":
+		item.VideoClipLength, err = strconv.Atoi(value)
+	case "video_embedding_scope":
+		for _, scope := range strings.FieldsFunc(value, func(r rune) bool { return r == '|' || r == ';' }) {
+			if scope = strings.TrimSpace(scope); scope != "" {
+				item.VideoEmbeddingScope = append(item.VideoEmbeddingScope, scope)
+			}
+		}
+	case "enable_video_stream":
+		var enabled bool
+		enabled, err = strconv.ParseBool(value)
+		item.EnableVideoStream = &enabled
+	case "user_metadata":
+		var metadata map[string]string
+		if err = json.Unmarshal([]byte(value), &metadata); err == nil {
+			setUserMetadata(&item.TasksCreateRequest, metadata)
+		}
+	default:
+		key, ok := strings.CutPrefix(column, manifestMetadataPrefix)
+		if !ok {
+			return fmt.Errorf("unknown column")
+		}
+		setUserMetadata(&item.TasksCreateRequest, map[string]string{key: value})
+	}
+	return err
+}
+
+func setUserMetadata(item *models.TasksCreateRequest, metadata map[string]string) {
+	if item.UserMetadata == nil {
+		item.UserMetadata = make(map[string]string, len(metadata))
+	}
+	maps.Copy(item.UserMetadata, metadata)
+}
+
+func validateManifestItem(item *models.TasksCreateRequest) error {
+	if (item.VideoFile == "") == (item.VideoURL == "") {
+		return fmt.Errorf("exactly one of video_file or video_url must be set")
+	}
+	return nil
+}
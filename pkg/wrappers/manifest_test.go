@@ -0,0 +1,77 @@
+package wrappers_test
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/favourthemaster/twelvelabs-go-sdk/pkg/wrappers"
+)
+
+func TestLoadBulkManifestResolvesRelativePaths(t *testing.T) {
+	dir := t.TempDir()
+	absolute := filepath.Join(t.TempDir(), "absolute.mp4")
+	manifests := map[string]string{
+		"catalogue.csv": "video_file\nclips/intro.mp4\n" + absolute + "\n",
+		"catalogue.jsonl": `{"video_file":"clips/intro.mp4"}` + "\n" +
+			`{"video_file":"` + filepath.ToSlash(absolute) + `"}` + "\n" +
+			`{"video_url":"https://example.com/video.mp4"}` + "\n",
+	}
+
+	for name, content := range manifests {
+		t.Run(name, func(t *testing.T) {
+			path := filepath.Join(dir, name)
+			if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+				t.Fatal(err)
+			}
+			items, err := wrappers.LoadBulkManifest(path)
+			if err != nil {
+				t.Fatalf("LoadBulkManifest: %v", err)
+			}
+			if want := filepath.Join(dir, "clips", "intro.mp4"); items[0].VideoFile != want {
+				t.Errorf("relative path = %q, want %q", items[0].VideoFile, want)
+			}
+			if items[1].VideoFile != absolute {
+				t.Errorf("absolute path = %q, want %q", items[1].VideoFile, absolute)
+			}
+			if len(items) > 2 && items[2].VideoFile != "" {
+				t.Errorf("URL item has video_file %q", items[2].VideoFile)
+			}
+		})
+	}
+}
+
+func TestReadManifestEnableVideoStream(t *testing.T) {
+	manifests := map[string]func() ([]wrappers.BulkTaskItem, error){
+		"csv": func() ([]wrappers.BulkTaskItem, error) {
+			return wrappers.ReadCSVManifest(strings.NewReader("video_url,enable_video_stream\na,\nb,false\nc,true\n"))
+		},
+		"jsonl": func() ([]wrappers.BulkTaskItem, error) {
+			return wrappers.ReadJSONLManifest(strings.NewReader(`{"video_url":"a"}` + "\n" +
+				`{"video_url":"b","enable_video_stream":false}` + "\n" +
+				`{"video_url":"c","enable_video_stream":true}` + "\n"))
+		},
+	}
+
+	for name, read := range manifests {
+		t.Run(name, func(t *testing.T) {
+			items, err := read()
+			if err != nil {
+				t.Fatalf("read manifest: %v", err)
+			}
+			if len(items) != 3 {
+				t.Fatalf("read %d items, want 3", len(items))
+			}
+			if items[0].EnableVideoStream != nil {
+				t.Errorf("unset cell overrides enable_video_stream with %v", *items[0].EnableVideoStream)
+			}
+			if override := items[1].EnableVideoStream; override == nil || *override {
+				t.Errorf("false cell: override = %v, want false", override)
+			}
+			if override := items[2].EnableVideoStream; override == nil || !*override {
+				t.Errorf("true cell: override = %v, want true", override)
+			}
+		})
+	}
+}
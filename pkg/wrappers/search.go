@@ -5,6 +5,7 @@ import (
 
 	"github.com/favourthemaster/twelvelabs-go-sdk/pkg/errors"
 	"github.com/favourthemaster/twelvelabs-go-sdk/pkg/models"
+	"github.com/favourthemaster/twelvelabs-go-sdk/pkg/pagination"
 	"github.com/favourthemaster/twelvelabs-go-sdk/pkg/services"
 )
 
@@ -29,7 +30,8 @@ func NewSearchWrapper(service *services.SearchService) *SearchWrapper {
 //
 // Media queries:
 //   - Set QueryMediaType to "image", "video", or "audio"
-//   - Provide either QueryMediaURL (for web URLs) or QueryMediaFile (for local files)
+//   - Provide either QueryMediaURL (for web URLs), QueryMediaFile (for local files)
+//     or QueryMediaSource (for readers and in-memory content)
 //   - QueryMediaURL takes precedence if both are specified
 //
 // Parameters:
@@ -61,7 +63,7 @@ func (sw *SearchWrapper) Query(ctx context.Context, request *models.SearchQueryR
 	// Use the existing SearchQueryRequest from search service
 	results, err := sw.service.Query(ctx, request)
 	if err != nil {
-		return nil, errors.NewServiceError("Search", "search query failed: "+err.Error())
+		return nil, errors.WrapServiceError("Search", "search query failed", err)
 	}
 	// Return the complete response directly (no need to wrap it again)
 	return results, nil
@@ -96,6 +98,48 @@ func (sw *SearchWrapper) Retrieve(ctx context.Context, pageToken string) (*model
 	return sw.service.Retrieve(ctx, pageToken)
 }
 
+// QueryPage performs a search like Query and returns its first page of results.
+// Use the page's Next and Prev methods, or its Pager, to move through the results
+// instead of handling page tokens.
+//
+// Example:
+//
+//	page, err := client.Search.QueryPage(ctx, &models.SearchQueryRequest{
+//	    IndexID:       "your_index_id",
+//	    QueryText:     "person running in park",
+//	    SearchOptions: []string{"visual"},
+//	})
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	for result, err := range page.Pager().Items(ctx) {
+//	    if err != nil {
+//	        log.Fatal(err)
+//	    }
+//	    fmt.Printf("%s %.1f-%.1fs\n", result.VideoID, result.Start, result.End)
+//	}
+func (sw *SearchWrapper) QueryPage(ctx context.Context, request *models.SearchQueryRequest) (*pagination.Page[models.SearchResult], error) {
+	page, err := sw.service.QueryPage(ctx, request)
+	if err != nil {
+		return nil, errors.WrapServiceError("Search", "search query failed", err)
+	}
+	return page, nil
+}
+
+// SearchPage performs a search like Search and returns a page of results.
+func (sw *SearchWrapper) SearchPage(ctx context.Context, request *models.SearchRequest) (*pagination.Page[models.SearchResult], error) {
+	page, err := sw.service.SearchPage(ctx, request)
+	if err != nil {
+		return nil, errors.WrapServiceError("Search", "search failed", err)
+	}
+	return page, nil
+}
+
+// RetrievePage gets the page of search results identified by a page token.
+func (sw *SearchWrapper) RetrievePage(ctx context.Context, pageToken string) (*pagination.Page[models.SearchResult], error) {
+	return sw.service.RetrievePage(ctx, pageToken)
+}
+
 // SearchByText is a convenience method for text-based semantic searches.
 // This method simplifies common text search scenarios.
 //
@@ -172,7 +216,7 @@ func (sw *SearchWrapper) Search(ctx context.Context, request *models.SearchReque
 	// Use the existing Search method from the base service
 	results, err := sw.service.Search(ctx, request)
 	if err != nil {
-		return nil, errors.NewServiceError("Search", "search failed: "+err.Error())
+		return nil, errors.WrapServiceError("Search", "search failed", err)
 	}
 	return results, nil
 }
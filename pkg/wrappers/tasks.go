@@ -2,12 +2,20 @@ package wrappers
 
 import (
 	"context"
+	stderrors "errors"
 	"fmt"
+	"iter"
+	"log/slog"
+	"maps"
+	"slices"
 	"time"
 
 	"github.com/favourthemaster/twelvelabs-go-sdk/pkg/errors"
 	"github.com/favourthemaster/twelvelabs-go-sdk/pkg/models"
+	"github.com/favourthemaster/twelvelabs-go-sdk/pkg/pagination"
+	"github.com/favourthemaster/twelvelabs-go-sdk/pkg/poll"
 	"github.com/favourthemaster/twelvelabs-go-sdk/pkg/services"
+	"github.com/favourthemaster/twelvelabs-go-sdk/pkg/telemetry"
 )
 
 // TasksWrapper provides enhanced task management capabilities for video upload and processing,
@@ -48,10 +56,11 @@ func (tw *TasksWrapper) Create(ctx context.Context, request *models.TasksCreateR
 	return tw.service.Create(ctx, request)
 }
 
-// List retrieves tasks with optional filtering by status, index ID, or other criteria.
+// List retrieves a single page of tasks with optional filtering by index, status,
+// file name or creation time. Use Iterate or All to walk every page.
 //
 // Parameters:
-//   - filters: Map of filter criteria (e.g., {"status": "completed", "index_id": "your_index"})
+//   - opts: Filters, sort order and page; nil lists the first page of all tasks
 //
 // Returns:
 //   - Array of Task objects matching the filter criteria
@@ -59,17 +68,60 @@ func (tw *TasksWrapper) Create(ctx context.Context, request *models.TasksCreateR
 //
 // Example:
 //
-//	// Get all completed tasks
-//	tasks, err := client.Tasks.List(map[string]string{
-//	    "status": "completed",
+//	// Get the tasks of an index that finished
+//	tasks, err := client.Tasks.List(ctx, &models.TaskListOptions{
+//	    IndexID: "your_index_id",
+//	    Status:  []models.TaskStatus{models.TaskStatusReady, models.TaskStatusFailed},
 //	})
+func (tw *TasksWrapper) List(ctx context.Context, opts *models.TaskListOptions) ([]models.Task, error) {
+	return tw.service.List(ctx, opts)
+}
+
+// Iterate returns an iterator over every task matching opts. Pages are fetched
+// lazily as the loop reaches them, so breaking out early saves requests. The
+// iteration stops after yielding an error.
 //
-//	// Get tasks for specific index
-//	tasks, err := client.Tasks.List(map[string]string{
-//	    "index_id": "your_index_id",
-//	})
-func (tw *TasksWrapper) List(ctx context.Context, filters map[string]string) ([]models.Task, error) {
-	return tw.service.List(ctx, filters)
+// Example:
+//
+//	for task, err := range client.Tasks.Iterate(ctx, &models.TaskListOptions{IndexID: "your_index_id"}) {
+//	    if err != nil {
+//	        log.Fatal(err)
+//	    }
+//	    fmt.Println(task.ID, task.Status)
+//	}
+func (tw *TasksWrapper) Iterate(ctx context.Context, opts *models.TaskListOptions) iter.Seq2[models.Task, error] {
+	return tw.service.Iterate(ctx, opts)
+}
+
+// All retrieves every task matching opts, following the pages to the end.
+// On error it returns the tasks retrieved so far along with the error.
+func (tw *TasksWrapper) All(ctx context.Context, opts *models.TaskListOptions) ([]models.Task, error) {
+	return tw.service.All(ctx, opts)
+}
+
+// ListPage retrieves a single page of tasks with its totals and cursors. Use the
+// page's Next and Prev methods to move through the listing with the same filters.
+//
+// Example:
+//
+//	page, err := client.Tasks.ListPage(ctx, &models.TaskListOptions{PageLimit: 20})
+//	for err == nil {
+//	    for _, task := range page.Items {
+//	        fmt.Println(task.ID, task.Status)
+//	    }
+//	    page, err = page.Next(ctx)
+//	}
+//	if !errors.Is(err, pagination.ErrNoPage) {
+//	    log.Fatal(err)
+//	}
+func (tw *TasksWrapper) ListPage(ctx context.Context, opts *models.TaskListOptions) (*pagination.Page[models.Task], error) {
+	return tw.service.ListPage(ctx, opts)
+}
+
+// Pager returns a pager over the tasks matching opts. Set its Prefetch field to
+// fetch pages in the background while earlier ones are processed.
+func (tw *TasksWrapper) Pager(opts *models.TaskListOptions) *pagination.Pager[models.Task] {
+	return tw.service.Pager(opts)
 }
 
 // Retrieve gets detailed information about a specific task by its ID.
@@ -94,6 +146,11 @@ func (tw *TasksWrapper) Retrieve(ctx context.Context, taskID string) (*models.Ta
 
 // CreateBulkRequest represents a request for creating multiple video indexing tasks simultaneously.
 // This enables efficient batch processing of multiple videos.
+//
+// Videos can be given as plain lists of VideoFiles and VideoURLs, or as Items carrying
+// the full task options of each video. Defaults are merged into every video: fields
+// left empty on an item are taken from Defaults, and UserMetadata is merged key by key
+// with the item's values taking precedence.
 type CreateBulkRequest struct {
 	// IndexID is the target index for all videos
 	IndexID string `json:"index_id"`
@@ -101,28 +158,76 @@ type CreateBulkRequest struct {
 	VideoFiles []string `json:"video_files,omitempty"`
 	// VideoURLs contains publicly accessible video URLs (optional)
 	VideoURLs []string `json:"video_urls,omitempty"`
-	// EnableVideoStream enables video streaming for processed content (optional)
+	// Items contains complete task specifications, one per video (optional).
+	// See LoadBulkManifest to read them from a CSV or JSONL file.
+	Items []BulkTaskItem `json:"items,omitempty"`
+	// Defaults holds task options shared by every video (optional). Its video
+	// source fields are ignored.
+	Defaults *models.TasksCreateRequest `json:"defaults,omitempty"`
+	// EnableVideoStream enables video streaming for every video that does not set
+	// it itself (optional)
 	EnableVideoStream bool `json:"enable_video_stream,omitempty"`
+	// Workers is the number of tasks created concurrently (optional, defaults to 4)
+	Workers int `json:"-"`
+	// Mode selects best-effort (default) or fail-fast processing (optional)
+	Mode BulkMode `json:"-"`
+	// OnProgress is called periodically while each local file is being uploaded,
+	// with the file path as source (optional)
+	OnProgress func(source string, progress models.UploadProgress) `json:"-"`
+}
+
+// BulkTaskItem is the task specification of a single video of CreateBulk.
+type BulkTaskItem struct {
+	models.TasksCreateRequest
+	// EnableVideoStream overrides the video streaming default of the batch for this
+	// video when set (optional). False leaves the option unset, so that the API
+	// default applies.
+	EnableVideoStream *bool `json:"enable_video_stream,omitempty"`
+}
+
+// BulkTaskResult is the outcome of a single input of CreateBulk.
+type BulkTaskResult struct {
+	// Source is the video file path or URL of the input.
+	Source string
+	// Task is the created task, or nil if the input failed or was skipped.
+	Task *models.Task
+	// Err is why the input has no task. Items skipped because the batch stopped
+	// early carry the context error or ErrBulkAborted.
+	Err error
+}
+
+// bulkItem is a single task to create in CreateBulk.
+type bulkItem struct {
+	source  string
+	request *models.TasksCreateRequest
 }
 
 // CreateBulk creates multiple video indexing tasks for batch processing of videos.
-// This method efficiently handles bulk video uploads and processing.
+// Tasks are created concurrently by a pool of request.Workers workers.
 //
 // Upload options:
 //   - Local files: Use VideoFiles to provide an array of file paths
 //   - Publicly accessible URLs: Use VideoURLs to provide an array of URLs
 //   - Mixed sources: Can combine both local files and URLs in a single request
+//   - Per-video options: Use Items for offsets, clip length, embedding scope or
+//     user metadata that differ between videos, with shared values in Defaults
+//
+// Failure handling:
+//   - BulkBestEffort (default): every input is attempted
+//   - BulkFailFast: the first failure stops the batch; remaining inputs fail with ErrBulkAborted
+//   - Cancelling ctx stops the batch; remaining inputs fail with the context error
 //
 // Parameters:
 //   - request: CreateBulkRequest with IndexID and video sources
 //
 // Returns:
-//   - Array of Task objects, one for each video
-//   - error if bulk task creation fails
+//   - One result per input, files first, then URLs, then items, in the order given
+//   - The failures of all inputs combined with errors.Join, each prefixed with its
+//     source, plus the context error if ctx was cancelled; nil if every task was created
 //
 // Example:
 //
-//	tasks, err := client.Tasks.CreateBulk(&wrappers.CreateBulkRequest{
+//	results, err := client.Tasks.CreateBulk(ctx, &wrappers.CreateBulkRequest{
 //	    IndexID: "your_index_id",
 //	    VideoFiles: []string{
 //	        "./videos/video1.mp4",
@@ -132,68 +237,166 @@ type CreateBulkRequest struct {
 //	        "https://example.com/video3.mp4",
 //	        "https://example.com/video4.mp4",
 //	    },
-//	    EnableVideoStream: true,
+//	    Workers: 8,
 //	})
-//	fmt.Printf("Created %d tasks\n", len(tasks))
-func (tw *TasksWrapper) CreateBulk(ctx context.Context, request *CreateBulkRequest) ([]models.Task, error) {
-	if len(request.VideoFiles) == 0 && len(request.VideoURLs) == 0 {
-		return nil, errors.NewValidationError("either VideoFiles or VideoURLs must be provided")
+//
+//	// Per-video options from a manifest, with shared defaults
+//	items, err := wrappers.LoadBulkManifest("./catalogue.csv")
+//	results, err = client.Tasks.CreateBulk(ctx, &wrappers.CreateBulkRequest{
+//	    Items: items,
+//	    Defaults: &models.TasksCreateRequest{
+//	        IndexID:      "your_index_id",
+//	        UserMetadata: map[string]string{"catalogue": "spring-2025"},
+//	    },
+//	})
+//	for _, result := range results {
+//	    if result.Err != nil {
+//	        fmt.Printf("%s failed: %v\n", result.Source, result.Err)
+//	        continue
+//	    }
+//	    fmt.Printf("%s -> task %s\n", result.Source, result.Task.ID)
+//	}
+func (tw *TasksWrapper) CreateBulk(ctx context.Context, request *CreateBulkRequest) ([]BulkTaskResult, error) {
+	if len(request.VideoFiles) == 0 && len(request.VideoURLs) == 0 && len(request.Items) == 0 {
+		return nil, errors.NewValidationError("either VideoFiles, VideoURLs or Items must be provided")
 	}
 
-	var tasks []models.Task
+	defaults := models.TasksCreateRequest{IndexID: request.IndexID, EnableVideoStream: request.EnableVideoStream}
+	if request.Defaults != nil {
+		defaults = mergeTaskRequest(*request.Defaults, defaults)
+	}
 
-	// Process video files
-	if len(request.VideoFiles) > 0 {
-		for _, videoFile := range request.VideoFiles {
-			taskRequest := &models.TasksCreateRequest{
-				IndexID:           request.IndexID,
-				VideoFile:         videoFile,
-				EnableVideoStream: request.EnableVideoStream,
-			}
+	specs := make([]BulkTaskItem, 0, len(request.VideoFiles)+len(request.VideoURLs)+len(request.Items))
+	for _, videoFile := range request.VideoFiles {
+		specs = append(specs, BulkTaskItem{TasksCreateRequest: models.TasksCreateRequest{VideoFile: videoFile}})
+	}
+	for _, videoURL := range request.VideoURLs {
+		specs = append(specs, BulkTaskItem{TasksCreateRequest: models.TasksCreateRequest{VideoURL: videoURL}})
+	}
+	specs = append(specs, request.Items...)
 
-			task, err := tw.service.Create(ctx, taskRequest)
-			if err != nil {
-				fmt.Printf("Error processing file %s: %v\n", videoFile, err)
-				continue
+	items := make([]bulkItem, len(specs))
+	for i, spec := range specs {
+		taskRequest := mergeTaskRequest(spec.TasksCreateRequest, defaults)
+		if spec.EnableVideoStream != nil {
+			taskRequest.EnableVideoStream = *spec.EnableVideoStream
+		}
+		source := taskSource(&taskRequest)
+		if taskRequest.OnProgress == nil && request.OnProgress != nil {
+			taskRequest.OnProgress = func(progress models.UploadProgress) {
+				request.OnProgress(source, progress)
 			}
-			tasks = append(tasks, *task)
 		}
+		items[i] = bulkItem{source: source, request: &taskRequest}
 	}
 
-	// Process video URLs
-	if len(request.VideoURLs) > 0 {
-		for _, videoURL := range request.VideoURLs {
-			taskRequest := &models.TasksCreateRequest{
-				IndexID:           request.IndexID,
-				VideoURL:          videoURL,
-				EnableVideoStream: request.EnableVideoStream,
-			}
+	return tw.createBulk(ctx, items, request.Workers, request.Mode)
+}
 
-			task, err := tw.service.Create(ctx, taskRequest)
-			if err != nil {
-				fmt.Printf("Error processing URL %s: %v\n", videoURL, err)
-				continue
-			}
-			tasks = append(tasks, *task)
-		}
+// mergeTaskRequest returns item with its empty options filled from defaults.
+// The video source is never taken from defaults.
+func mergeTaskRequest(item, defaults models.TasksCreateRequest) models.TasksCreateRequest {
+	if item.IndexID == "" {
+		item.IndexID = defaults.IndexID
+	}
+	if item.VideoStartOffsetSec == 0 {
+		item.VideoStartOffsetSec = defaults.VideoStartOffsetSec
+	}
+	if item.VideoEndOffsetSec == 0 {
+		item.VideoEndOffsetSec = defaults.VideoEndOffsetSec
+	}
+	if item.VideoClipLength == 0 {
+		item.VideoClipLength = defaults.VideoClipLength
+	}
+	if len(item.VideoEmbeddingScope) == 0 {
+		item.VideoEmbeddingScope = slices.Clone(defaults.VideoEmbeddingScope)
 	}
+	item.EnableVideoStream = item.EnableVideoStream || defaults.EnableVideoStream
+	if len(defaults.UserMetadata) > 0 {
+		metadata := maps.Clone(defaults.UserMetadata)
+		maps.Copy(metadata, item.UserMetadata)
+		item.UserMetadata = metadata
+	}
+	if item.OnProgress == nil {
+		item.OnProgress = defaults.OnProgress
+	}
+	return item
+}
 
-	return tasks, nil
+// taskSource describes where the video of a task request comes from.
+func taskSource(request *models.TasksCreateRequest) string {
+	switch {
+	case request.VideoSource != nil && request.VideoSource.Path != "":
+		return request.VideoSource.Path
+	case request.VideoSource != nil:
+		return request.VideoSource.Filename
+	case request.VideoFile != "":
+		return request.VideoFile
+	default:
+		return request.VideoURL
+	}
+}
+
+// createBulk creates the tasks for items with a pool of workers.
+func (tw *TasksWrapper) createBulk(ctx context.Context, items []bulkItem, workers int, mode BulkMode) ([]BulkTaskResult, error) {
+	results := make([]BulkTaskResult, len(items))
+	for i, item := range items {
+		results[i].Source = item.source
+	}
+
+	run := &bulkRun{
+		workers: workers,
+		mode:    mode,
+		source:  func(i int) string { return items[i].source },
+		do: func(ctx context.Context, i int) error {
+			task, err := tw.service.Create(ctx, items[i].request)
+			results[i].Task = task
+			return err
+		},
+		onFailure: func(i int, err error) {
+			tw.service.Client.Logger().Warn("failed to create task",
+				slog.String("source", items[i].source), slog.Any("error", err))
+		},
+	}
+	errs, err := run.run(ctx, len(items))
+	for i := range results {
+		results[i].Err = errs[i]
+	}
+	return results, err
+}
+
+// isContextError reports whether err is caused by a cancelled or expired context.
+func isContextError(err error) bool {
+	return stderrors.Is(err, context.Canceled) || stderrors.Is(err, context.DeadlineExceeded)
 }
 
 // WaitForDoneOptions represents options for the WaitForDone method
 type WaitForDoneOptions struct {
+	// SleepInterval is the delay after the first poll, grown with backoff while the
+	// status does not change (optional, defaults to 5s)
 	SleepInterval time.Duration
-	Callback      func(*models.Task) error
+	// MaxInterval caps the delay between polls (optional, defaults to 30s)
+	MaxInterval time.Duration
+	// MaxErrors is the number of consecutive failed polls tolerated (optional, defaults to 3)
+	MaxErrors int
+	// Callback is called with the task after every poll; returning an error stops the wait (optional)
+	Callback func(*models.Task) error
+	// OnStatusChange is called when the task status changes, including the first
+	// status seen with an empty previous status (optional)
+	OnStatusChange func(previous models.TaskStatus, task *models.Task)
 }
 
 // WaitForDone waits for a task to complete by periodically checking its status.
+// Polling backs off exponentially while the status stays the same and stops when
+// the task is ready, failed or in error, or when ctx is done.
 //
 // Parameters:
 //   - taskID: The unique identifier of the task to wait for
 //   - options: Options for the wait operation including sleep interval and callback
 //
-// Returns: The completed task response
+// Returns:
+//   - The task in its final status; a task that failed to index is returned without error
+//   - error if ctx is done, polling fails options.MaxErrors times in a row, or the callback fails
 //
 // Example:
 //
@@ -212,60 +415,52 @@ type WaitForDoneOptions struct {
 //	        return nil
 //	    },
 //	})
-func (tw *TasksWrapper) WaitForDone(ctx context.Context, taskID string, options *WaitForDoneOptions) (*models.Task, error) {
+func (tw *TasksWrapper) WaitForDone(ctx context.Context, taskID string, options *WaitForDoneOptions) (_ *models.Task, err error) {
+	ctx, span := tw.service.Client.Telemetry().StartSpan(ctx, "twelvelabs.tasks.wait_for_done", telemetry.AttrTaskID.String(taskID))
+	defer func() { telemetry.EndSpan(span, err) }()
+
 	if options == nil {
 		options = &WaitForDoneOptions{}
 	}
 
-	sleepInterval := options.SleepInterval
-	if sleepInterval <= 0 {
-		sleepInterval = 5 * time.Second
-	}
-
-	callback := options.Callback
-
-	// Get initial task
-	task, err := tw.service.Retrieve(ctx, taskID)
-	if err != nil {
-		return nil, errors.NewServiceError("Tasks", "failed to retrieve initial task: "+err.Error())
+	var callbackErr error
+	poller := &poll.Poller[*models.Task]{
+		Interval:    options.SleepInterval,
+		MaxInterval: options.MaxInterval,
+		MaxErrors:   options.MaxErrors,
 	}
-
-	// Define done statuses
-	doneStatuses := map[string]bool{
-		"ready":  true,
-		"failed": true,
-	}
-
-	// Continue checking until it's done
-	for !doneStatuses[task.Status] {
-		time.Sleep(sleepInterval)
-
-		task, err = tw.service.Retrieve(ctx, taskID)
-		if err != nil {
-			fmt.Printf("Retrieving task failed: %v. Retrying...\n", err)
-			continue
+	if options.Callback != nil {
+		poller.OnPoll = func(task *models.Task) error {
+			callbackErr = options.Callback(task)
+			return callbackErr
 		}
-
-		// Call callback if provided
-		if callback != nil {
-			if err := callback(task); err != nil {
-				return nil, errors.NewServiceError("Tasks", "callback error: "+err.Error())
-			}
+	}
+	if options.OnStatusChange != nil {
+		poller.OnChange = func(previous string, task *models.Task) error {
+			options.OnStatusChange(models.TaskStatus(previous), task)
+			return nil
 		}
 	}
 
+	task, err := tw.service.Wait(ctx, taskID, poller)
+	if callbackErr != nil {
+		return nil, errors.WrapServiceError("Tasks", "callback error", callbackErr)
+	}
+	if err != nil {
+		return nil, waitError(err)
+	}
 	return task, nil
 }
 
 // WaitForCompletion waits for a task to complete, calling the optional callback function
-// with status updates. This method polls the task status at regular intervals.
+// with status updates. Polling works as in WaitForDone with the default options.
 //
 // Parameters:
 //   - taskID: The task ID to monitor
-//   - callback: Optional function called with each status update (can be nil)
+//   - callback: Optional function called with the status after every poll (can be nil)
 //
 // Returns:
-//   - error if the task fails or polling encounters an error
+//   - error if the task does not become ready, ctx is done or polling keeps failing
 //
 // Example:
 //
@@ -277,35 +472,11 @@ func (tw *TasksWrapper) WaitForDone(ctx context.Context, taskID string, options
 //	} else {
 //	    fmt.Println("Task completed successfully!")
 //	}
-func (tw *TasksWrapper) WaitForCompletion(ctx context.Context, taskID string, callback func(string)) error {
-	// Get initial task
-	task, err := tw.service.Retrieve(ctx, taskID)
-	if err != nil {
-		return errors.NewServiceError("Tasks", "failed to retrieve initial task: "+err.Error())
-	}
+func (tw *TasksWrapper) WaitForCompletion(ctx context.Context, taskID string, callback func(string)) (err error) {
+	ctx, span := tw.service.Client.Telemetry().StartSpan(ctx, "twelvelabs.tasks.wait_for_completion", telemetry.AttrTaskID.String(taskID))
+	defer func() { telemetry.EndSpan(span, err) }()
 
-	// Define done statuses
-	doneStatuses := map[string]bool{
-		"ready":  true,
-		"failed": true,
-	}
-
-	// Continue checking until it's done
-	for !doneStatuses[task.Status] {
-		time.Sleep(5 * time.Second)
-
-		task, err = tw.service.Retrieve(ctx, taskID)
-		if err != nil {
-			return errors.NewServiceError("Tasks", "retrieving task failed: "+err.Error())
-		}
-
-		// Call callback if provided
-		if callback != nil {
-			callback(task.Status)
-		}
-	}
-
-	return nil
+	return tw.waitForCompletion(ctx, taskID, callback)
 }
 
 // WaitForCompletionWithTimeout waits for task completion with a specified timeout.
@@ -317,7 +488,8 @@ func (tw *TasksWrapper) WaitForCompletion(ctx context.Context, taskID string, ca
 //   - callback: Optional function called with status updates
 //
 // Returns:
-//   - error if timeout exceeded, task fails, or polling encounters an error
+//   - A TimeoutError if timeout is exceeded
+//   - error if the task does not become ready, ctx is done or polling keeps failing
 //
 // Example:
 //
@@ -328,40 +500,44 @@ func (tw *TasksWrapper) WaitForCompletion(ctx context.Context, taskID string, ca
 //	        fmt.Printf("Status: %s\n", status)
 //	    },
 //	)
-func (tw *TasksWrapper) WaitForCompletionWithTimeout(ctx context.Context, taskID string, timeout time.Duration, callback func(string)) error {
-	// Get initial task
-	task, err := tw.service.Retrieve(ctx, taskID)
-	if err != nil {
-		return errors.NewServiceError("Tasks", "failed to retrieve initial task: "+err.Error())
-	}
-
-	// Define done statuses
-	doneStatuses := map[string]bool{
-		"ready":  true,
-		"failed": true,
-	}
+func (tw *TasksWrapper) WaitForCompletionWithTimeout(ctx context.Context, taskID string, timeout time.Duration, callback func(string)) (err error) {
+	ctx, span := tw.service.Client.Telemetry().StartSpan(ctx, "twelvelabs.tasks.wait_for_completion", telemetry.AttrTaskID.String(taskID))
+	defer func() { telemetry.EndSpan(span, err) }()
 
-	// Set a deadline for completion
-	deadline := time.Now().Add(timeout)
+	waitCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
 
-	// Continue checking until it's done or timeout
-	for !doneStatuses[task.Status] {
-		if time.Now().After(deadline) {
-			return errors.NewTimeoutError("timeout exceeded while waiting for task completion")
-		}
-
-		time.Sleep(5 * time.Second)
-
-		task, err = tw.service.Retrieve(ctx, taskID)
-		if err != nil {
-			return errors.NewServiceError("Tasks", "retrieving task failed: "+err.Error())
-		}
+	err = tw.waitForCompletion(waitCtx, taskID, callback)
+	if err != nil && ctx.Err() == nil && waitCtx.Err() != nil {
+		return errors.NewTimeoutError("timeout exceeded while waiting for task completion")
+	}
+	return err
+}
 
-		// Call callback if provided
-		if callback != nil {
-			callback(task.Status)
+// waitForCompletion polls the task until it is done and fails unless it is ready.
+func (tw *TasksWrapper) waitForCompletion(ctx context.Context, taskID string, callback func(string)) error {
+	poller := &poll.Poller[*models.Task]{}
+	if callback != nil {
+		poller.OnPoll = func(task *models.Task) error {
+			callback(string(task.Status))
+			return nil
 		}
 	}
 
+	task, err := tw.service.Wait(ctx, taskID, poller)
+	if err != nil {
+		return waitError(err)
+	}
+	if !task.Status.IsSuccess() {
+		return errors.NewServiceError("Tasks", fmt.Sprintf("task %s finished with status %s", taskID, task.Status))
+	}
 	return nil
 }
+
+// waitError wraps a polling failure, leaving context errors as they are.
+func waitError(err error) error {
+	if isContextError(err) {
+		return err
+	}
+	return errors.WrapServiceError("Tasks", "retrieving task failed", err)
+}
@@ -0,0 +1,53 @@
+package wrappers_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"sync"
+	"testing"
+
+	"github.com/favourthemaster/twelvelabs-go-sdk/pkg/client"
+	"github.com/favourthemaster/twelvelabs-go-sdk/pkg/models"
+	"github.com/favourthemaster/twelvelabs-go-sdk/pkg/wrappers"
+)
+
+func TestCreateBulkItemOverridesEnableVideoStream(t *testing.T) {
+	var mu sync.Mutex
+	received := make(map[string][]string)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseMultipartForm(1 << 20); err != nil {
+			t.Errorf("parse form: %v", err)
+		}
+		mu.Lock()
+		received[r.FormValue("video_url")] = r.MultipartForm.Value["enable_video_stream"]
+		mu.Unlock()
+		writeJSON(w, models.Task{ID: "task-" + r.FormValue("video_url")})
+	}))
+	defer server.Close()
+
+	c := client.NewClient(&client.Options{BaseURL: server.URL, APIKey: "test-key"})
+	tasks := wrappers.NewTasksWrapper(c.Tasks)
+
+	disabled, enabled := false, true
+	_, err := tasks.CreateBulk(context.Background(), &wrappers.CreateBulkRequest{
+		Items: []wrappers.BulkTaskItem{
+			{TasksCreateRequest: models.TasksCreateRequest{VideoURL: "inherits"}},
+			{TasksCreateRequest: models.TasksCreateRequest{VideoURL: "disabled"}, EnableVideoStream: &disabled},
+			{TasksCreateRequest: models.TasksCreateRequest{VideoURL: "enabled"}, EnableVideoStream: &enabled},
+		},
+		Defaults: &models.TasksCreateRequest{IndexID: "index-1", EnableVideoStream: true},
+	})
+	if err != nil {
+		t.Fatalf("CreateBulk: %v", err)
+	}
+
+	// A disabled item leaves the option out so that the API default applies.
+	want := map[string][]string{"inherits": {"true"}, "disabled": nil, "enabled": {"true"}}
+	for source, value := range want {
+		if got := received[source]; !slices.Equal(got, value) {
+			t.Errorf("%s: enable_video_stream = %v, want %v", source, got, value)
+		}
+	}
+}
@@ -0,0 +1,526 @@
+package wrappers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	stderrors "errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/favourthemaster/twelvelabs-go-sdk/pkg/errors"
+	"github.com/favourthemaster/twelvelabs-go-sdk/pkg/models"
+	"github.com/favourthemaster/twelvelabs-go-sdk/pkg/services"
+	"github.com/favourthemaster/twelvelabs-go-sdk/pkg/telemetry"
+)
+
+// urlBatchSize is the number of pre-signed chunk URLs requested at once.
+const urlBatchSize = 50
+
+// UploadsWrapper uploads large media files in chunks through resumable upload sessions.
+// Unlike TasksWrapper.Create, a failed chunk is retried on its own and an interrupted
+// upload can continue from the chunks already stored.
+type UploadsWrapper struct {
+	service *services.UploadsService
+}
+
+// NewUploadsWrapper creates a new UploadsWrapper instance.
+func NewUploadsWrapper(service *services.UploadsService) *UploadsWrapper {
+	return &UploadsWrapper{service: service}
+}
+
+// UploadOptions configures a chunked upload. All fields are optional.
+type UploadOptions struct {
+	// Filename is sent with the session. Defaults to the name of the source file.
+	Filename string
+	// Type is the media type of the asset: "video" (default), "audio" or "image".
+	Type string
+	// Concurrency is the number of chunks uploaded in parallel. Defaults to 4.
+	Concurrency int
+	// ChunkRetries is how many times a failed chunk is retried before the upload fails. Defaults to 3.
+	ChunkRetries int
+	// RetryBackoff is the wait before the first chunk retry, doubled after each attempt. Defaults to 1s.
+	RetryBackoff time.Duration
+	// StateFile is where the session state is saved after every chunk. If the file
+	// exists when the upload starts, the session it describes is resumed. It is
+	// removed once the upload completes and kept when it fails.
+	StateFile string
+	// OnProgress is called after every uploaded chunk.
+	OnProgress models.ProgressFunc
+}
+
+// UploadState is the session state saved to UploadOptions.StateFile.
+type UploadState struct {
+	UploadID    string                  `json:"upload_id"`
+	AssetID     string                  `json:"asset_id"`
+	Filename    string                  `json:"filename"`
+	TotalSize   int64                   `json:"total_size"`
+	ChunkSize   int64                   `json:"chunk_size"`
+	TotalChunks int                     `json:"total_chunks"`
+	Completed   []models.CompletedChunk `json:"completed_chunks"`
+}
+
+// UploadFile uploads a local file in chunks and returns the resulting asset.
+// See Upload for details.
+//
+// Example:
+//
+//	asset, err := client.Uploads.UploadFile(ctx, "./videos/keynote.mp4", &wrappers.UploadOptions{
+//	    Concurrency: 8,
+//	    StateFile:   "./keynote.upload.json",
+//	})
+//	if err != nil {
+//	    log.Fatal(err) // run again to resume from the state file
+//	}
+//	fmt.Println("Asset:", asset.ID)
+func (uw *UploadsWrapper) UploadFile(ctx context.Context, path string, options *UploadOptions) (*models.Asset, error) {
+	return uw.Upload(ctx, models.MediaFromPath(path), options)
+}
+
+// Upload uploads media in chunks through an upload session and returns the resulting asset.
+//
+// The upload:
+//   - Creates a session, or resumes the one saved in options.StateFile, trusting the
+//     server over the state file for which chunks are already stored
+//   - Uploads the missing chunks in parallel, retrying each failed chunk with backoff
+//   - Saves the state after every chunk so a crashed process can resume
+//   - Reports the chunks to complete the session and retrieves the asset
+//
+// Chunks are read at their offsets, so the source must be a file path, a byte slice
+// or a reader implementing io.ReaderAt. A reader whose Size is zero or less must
+// also implement io.Seeker so its size can be found. Empty sources are rejected.
+//
+// Parameters:
+//   - source: The media to upload
+//   - options: Upload options; nil uses the defaults
+//
+// Returns:
+//   - The asset created from the upload, ready to be indexed once processed
+//   - error if a chunk cannot be uploaded after its retries or the session fails
+func (uw *UploadsWrapper) Upload(ctx context.Context, source *models.MediaSource, options *UploadOptions) (_ *models.Asset, err error) {
+	opts := UploadOptions{}
+	if options != nil {
+		opts = *options
+	}
+	if opts.Type == "" {
+		opts.Type = "video"
+	}
+	if opts.Concurrency <= 0 {
+		opts.Concurrency = 4
+	}
+	if opts.ChunkRetries <= 0 {
+		opts.ChunkRetries = 3
+	}
+	if opts.RetryBackoff <= 0 {
+		opts.RetryBackoff = time.Second
+	}
+
+	reader, size, filename, closeSource, err := openUploadSource(source)
+	if err != nil {
+		return nil, errors.NewValidationError(err.Error())
+	}
+	defer closeSource()
+	if size <= 0 {
+		return nil, errors.NewValidationError("upload source is empty")
+	}
+	if opts.Filename != "" {
+		filename = opts.Filename
+	}
+	if filename == "" {
+		return nil, errors.NewValidationError("a file name is required to upload a reader or byte slice")
+	}
+
+	ctx, span := uw.service.Client.Telemetry().StartSpan(ctx, "twelvelabs.uploads.upload",
+		telemetry.AttrUploadBytes.Int64(size))
+	defer func() { telemetry.EndSpan(span, err) }()
+
+	upload := &chunkedUpload{service: uw.service, reader: reader, options: opts, urls: make(map[int]string)}
+	if err := upload.start(ctx, filename, size); err != nil {
+		return nil, err
+	}
+	if err := upload.uploadChunks(ctx); err != nil {
+		return nil, errors.WrapServiceError("Uploads", "chunked upload failed", err)
+	}
+
+	state := upload.state
+	slices.SortFunc(state.Completed, func(a, b models.CompletedChunk) int { return a.ChunkIndex - b.ChunkIndex })
+	if !upload.reported {
+		if err := uw.service.ReportChunks(ctx, state.UploadID, state.Completed); err != nil {
+			return nil, errors.WrapServiceError("Uploads", "failed to complete upload session", err)
+		}
+	}
+	asset, err := uw.service.RetrieveAsset(ctx, state.AssetID)
+	if err != nil {
+		return nil, errors.WrapServiceError("Uploads", "failed to retrieve uploaded asset", err)
+	}
+
+	if opts.StateFile != "" {
+		if err := os.Remove(opts.StateFile); err != nil && !os.IsNotExist(err) {
+			uw.service.Client.Logger().Warn("failed to remove upload state file",
+				slog.String("state_file", opts.StateFile), slog.Any("error", err))
+		}
+	}
+	return asset, nil
+}
+
+// LoadUploadState reads the session state saved by an interrupted upload.
+func LoadUploadState(path string) (*UploadState, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var state UploadState
+	if err := json.Unmarshal(data, &state); err != nil {
+		return nil, fmt.Errorf("invalid upload state file %s: %w", path, err)
+	}
+	return &state, nil
+}
+
+// openUploadSource returns random access to the content of source along with its
+// size and file name, and a function that releases it.
+func openUploadSource(source *models.MediaSource) (io.ReaderAt, int64, string, func(), error) {
+	noop := func() {}
+	switch {
+	case source == nil:
+		return nil, 0, "", noop, fmt.Errorf("upload source is required")
+	case source.Path != "":
+		file, err := os.Open(source.Path)
+		if err != nil {
+			return nil, 0, "", noop, err
+		}
+		info, err := file.Stat()
+		if err != nil {
+			_ = file.Close()
+			return nil, 0, "", noop, err
+		}
+		name := source.Filename
+		if name == "" {
+			name = filepath.Base(source.Path)
+		}
+		return file, info.Size(), name, func() { _ = file.Close() }, nil
+	case source.Data != nil:
+		return bytes.NewReader(source.Data), int64(len(source.Data)), source.Filename, noop, nil
+	case source.Reader != nil:
+		readerAt, ok := source.Reader.(io.ReaderAt)
+		if !ok {
+			return nil, 0, "", noop, fmt.Errorf("upload source reader must implement io.ReaderAt")
+		}
+		size := source.Size
+		if size <= 0 {
+			// A size of zero or less is unknown; chunks are read with ReadAt, so
+			// seeking to the end does not disturb them.
+			seeker, ok := source.Reader.(io.Seeker)
+			if !ok {
+				return nil, 0, "", noop, fmt.Errorf("upload source size is required because its reader is not seekable")
+			}
+			end, err := seeker.Seek(0, io.SeekEnd)
+			if err != nil {
+				return nil, 0, "", noop, err
+			}
+			size = end
+		}
+		return readerAt, size, source.Filename, noop, nil
+	default:
+		return nil, 0, "", noop, fmt.Errorf("upload source must set Path, Reader or Data")
+	}
+}
+
+// chunkedUpload holds the state of a single Upload call.
+type chunkedUpload struct {
+	service *services.UploadsService
+	reader  io.ReaderAt
+	options UploadOptions
+
+	mu       sync.Mutex
+	state    *UploadState
+	urls     map[int]string
+	reported bool // the resumed session was already completed
+	started  time.Time
+	resumed  int64
+	sent     int64
+}
+
+// start resumes the session saved in the state file, or creates a new one.
+func (u *chunkedUpload) start(ctx context.Context, filename string, size int64) error {
+	u.started = time.Now()
+	if u.options.StateFile != "" {
+		state, err := LoadUploadState(u.options.StateFile)
+		switch {
+		case err == nil:
+			if state.Filename != filename || state.TotalSize != size {
+				return errors.NewValidationError(fmt.Sprintf("upload state file %s belongs to a different upload (%s, %d bytes)",
+					u.options.StateFile, state.Filename, state.TotalSize))
+			}
+			resumed, err := u.resume(ctx, state)
+			if err != nil {
+				return err
+			}
+			if resumed {
+				return u.save()
+			}
+		case !os.IsNotExist(err):
+			return errors.NewValidationError(err.Error())
+		}
+	}
+
+	session, err := u.service.CreateSession(ctx, &models.UploadSessionCreateRequest{
+		Filename:  filename,
+		Type:      u.options.Type,
+		TotalSize: size,
+	})
+	if err != nil {
+		return errors.WrapServiceError("Uploads", "failed to create upload session", err)
+	}
+	if session.ChunkSize <= 0 && session.TotalChunks <= 0 {
+		return errors.NewServiceError("Uploads", "upload session has neither a chunk size nor a chunk count")
+	}
+
+	u.state = &UploadState{
+		UploadID:    session.UploadID,
+		AssetID:     session.AssetID,
+		Filename:    filename,
+		TotalSize:   size,
+		ChunkSize:   session.ChunkSize,
+		TotalChunks: session.TotalChunks,
+	}
+	if u.state.ChunkSize <= 0 {
+		u.state.ChunkSize = (size + int64(session.TotalChunks) - 1) / int64(session.TotalChunks)
+	}
+	if u.state.TotalChunks <= 0 {
+		u.state.TotalChunks = int((size + u.state.ChunkSize - 1) / u.state.ChunkSize)
+	}
+	for _, url := range session.UploadURLs {
+		u.urls[url.ChunkIndex] = url.URL
+	}
+	return u.save()
+}
+
+// resume continues the session described by state unless it is no longer active.
+// The chunks the server reports as completed replace those recorded in the state
+// file: chunks only recorded locally are uploaded again and chunks the server
+// already holds are skipped. It reports false when the session is gone or has
+// expired, so that a new one is started; any other failure is returned and leaves
+// the state file as it is.
+func (u *chunkedUpload) resume(ctx context.Context, state *UploadState) (bool, error) {
+	logger := u.service.Client.Logger()
+	status, err := u.service.RetrieveSession(ctx, state.UploadID)
+	if stderrors.Is(err, errors.ErrNotFound) || (err == nil && status.Status == "expired") {
+		logger.Warn("upload session cannot be resumed, starting a new one",
+			slog.String("upload_id", state.UploadID), slog.Any("error", err))
+		return false, nil
+	}
+	if err != nil {
+		return false, errors.WrapServiceError("Uploads", "failed to retrieve upload session "+state.UploadID, err)
+	}
+	if status.TotalChunks > 0 && status.TotalChunks != state.TotalChunks {
+		return false, errors.NewValidationError(fmt.Sprintf("upload session %s has %d chunks, state file %s has %d",
+			state.UploadID, status.TotalChunks, u.options.StateFile, state.TotalChunks))
+	}
+
+	local := len(state.Completed)
+	state.Completed = reconcileChunks(state, status.CompletedChunks)
+	if local != len(state.Completed) {
+		logger.Info("upload state file differs from the session",
+			slog.String("upload_id", state.UploadID),
+			slog.Int("local_chunks", local), slog.Int("session_chunks", len(state.Completed)))
+	}
+
+	u.state = state
+	u.reported = status.Status == "completed"
+	for _, chunk := range state.Completed {
+		u.resumed += chunk.ChunkSize
+	}
+	u.sent = u.resumed
+	logger.Info("resuming upload session", slog.String("upload_id", state.UploadID),
+		slog.Int("completed_chunks", len(state.Completed)), slog.Int("total_chunks", state.TotalChunks))
+	return true, nil
+}
+
+// reconcileChunks returns the chunks of the session that the server holds, in
+// the shape recorded in the state file.
+func reconcileChunks(state *UploadState, remote []models.CompletedChunk) []models.CompletedChunk {
+	completed := make([]models.CompletedChunk, 0, len(remote))
+	seen := make(map[int]bool, len(remote))
+	for _, chunk := range remote {
+		if chunk.ChunkIndex < 1 || chunk.ChunkIndex > state.TotalChunks || seen[chunk.ChunkIndex] {
+			continue
+		}
+		seen[chunk.ChunkIndex] = true
+		if chunk.ChunkSize <= 0 {
+			offset := int64(chunk.ChunkIndex-1) * state.ChunkSize
+			chunk.ChunkSize = min(state.ChunkSize, state.TotalSize-offset)
+		}
+		completed = append(completed, chunk)
+	}
+	return completed
+}
+
+// uploadChunks uploads every chunk not completed yet. The first chunk that fails
+// after its retries cancels the others.
+func (u *chunkedUpload) uploadChunks(ctx context.Context) error {
+	done := make(map[int]bool, len(u.state.Completed))
+	for _, chunk := range u.state.Completed {
+		done[chunk.ChunkIndex] = true
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		wg       sync.WaitGroup
+		errOnce  sync.Once
+		firstErr error
+	)
+	jobs := make(chan int)
+	for range u.options.Concurrency {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for index := range jobs {
+				if err := u.uploadChunk(ctx, index); err != nil {
+					errOnce.Do(func() {
+						firstErr = err
+						cancel()
+					})
+				}
+			}
+		}()
+	}
+
+feed:
+	for index := 1; index <= u.state.TotalChunks; index++ {
+		if done[index] {
+			continue
+		}
+		select {
+		case jobs <- index:
+		case <-ctx.Done():
+			break feed
+		}
+	}
+	close(jobs)
+	wg.Wait()
+
+	if firstErr != nil {
+		return firstErr
+	}
+	return ctx.Err()
+}
+
+// uploadChunk uploads one chunk, retrying with exponential backoff, and records it.
+func (u *chunkedUpload) uploadChunk(ctx context.Context, index int) error {
+	offset := int64(index-1) * u.state.ChunkSize
+	size := min(u.state.ChunkSize, u.state.TotalSize-offset)
+	chunk := io.NewSectionReader(u.reader, offset, size)
+
+	backoff := u.options.RetryBackoff
+	var err error
+	for attempt := 0; attempt <= u.options.ChunkRetries; attempt++ {
+		if attempt > 0 {
+			u.service.Client.Logger().Info("retrying chunk upload",
+				slog.Int("chunk_index", index), slog.Int("attempt", attempt+1), slog.Any("error", err))
+			timer := time.NewTimer(backoff)
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+				return ctx.Err()
+			case <-timer.C:
+			}
+			backoff *= 2
+		}
+
+		var url, etag string
+		url, err = u.url(ctx, index)
+		if err == nil {
+			etag, err = u.service.UploadChunk(ctx, url, chunk)
+		}
+		if err == nil {
+			return u.complete(models.CompletedChunk{ChunkIndex: index, Proof: etag, ProofType: "etag", ChunkSize: size})
+		}
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		// The URL may have expired; request a fresh one for the next attempt.
+		u.mu.Lock()
+		delete(u.urls, index)
+		u.mu.Unlock()
+	}
+	return fmt.Errorf("chunk %d failed after %d attempts: %w", index, u.options.ChunkRetries+1, err)
+}
+
+// url returns the pre-signed URL for a chunk, requesting a batch of URLs when it is not known.
+func (u *chunkedUpload) url(ctx context.Context, index int) (string, error) {
+	u.mu.Lock()
+	url, ok := u.urls[index]
+	u.mu.Unlock()
+	if ok {
+		return url, nil
+	}
+
+	count := min(urlBatchSize, u.state.TotalChunks-index+1)
+	urls, err := u.service.GetUploadURLs(ctx, u.state.UploadID, index, count)
+	if err != nil {
+		return "", err
+	}
+
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	for _, url := range urls {
+		if _, exists := u.urls[url.ChunkIndex]; !exists {
+			u.urls[url.ChunkIndex] = url.URL
+		}
+	}
+	if url, ok = u.urls[index]; !ok {
+		return "", fmt.Errorf("no upload URL returned for chunk %d", index)
+	}
+	return url, nil
+}
+
+// complete records an uploaded chunk, saves the state and reports progress.
+func (u *chunkedUpload) complete(chunk models.CompletedChunk) error {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	u.state.Completed = append(u.state.Completed, chunk)
+	u.sent += chunk.ChunkSize
+	if err := u.save(); err != nil {
+		return err
+	}
+
+	if u.options.OnProgress != nil {
+		progress := models.UploadProgress{BytesSent: u.sent, TotalBytes: u.state.TotalSize}
+		if elapsed := time.Since(u.started).Seconds(); elapsed > 0 {
+			progress.Throughput = float64(u.sent-u.resumed) / elapsed
+		}
+		if progress.Throughput > 0 && progress.TotalBytes > progress.BytesSent {
+			progress.ETA = time.Duration(float64(progress.TotalBytes-progress.BytesSent) / progress.Throughput * float64(time.Second))
+		}
+		u.options.OnProgress(progress)
+	}
+	return nil
+}
+
+// save writes the state file atomically. The caller must hold u.mu or own u exclusively.
+func (u *chunkedUpload) save() error {
+	if u.options.StateFile == "" {
+		return nil
+	}
+	data, err := json.MarshalIndent(u.state, "", "  ")
+	if err != nil {
+		return err
+	}
+	tmp := u.options.StateFile + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o600); err != nil {
+		return fmt.Errorf("failed to save upload state: %w", err)
+	}
+	if err := os.Rename(tmp, u.options.StateFile); err != nil {
+		return stderrors.Join(fmt.Errorf("failed to save upload state: %w", err), os.Remove(tmp))
+	}
+	return nil
+}
@@ -0,0 +1,335 @@
+package wrappers_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/favourthemaster/twelvelabs-go-sdk/pkg/client"
+	"github.com/favourthemaster/twelvelabs-go-sdk/pkg/models"
+	"github.com/favourthemaster/twelvelabs-go-sdk/pkg/wrappers"
+)
+
+// uploadServer fakes the multipart upload API and the storage its pre-signed URLs point to.
+type uploadServer struct {
+	t         *testing.T
+	content   []byte
+	chunkSize int
+	api       *httptest.Server
+	storage   *httptest.Server
+
+	storageHandler http.Handler
+
+	mu       sync.Mutex
+	failing  int            // chunk index whose uploads fail
+	sessions int            // upload sessions created
+	session  int            // status code of session lookups, if not 200
+	state    string         // status of the session, if not "active"
+	stored   map[int][]byte // chunks held by storage
+	puts     map[int]int    // successful uploads per chunk
+	reported []models.CompletedChunk
+}
+
+func newUploadServer(t *testing.T, content []byte, chunkSize int) *uploadServer {
+	s := &uploadServer{
+		t:         t,
+		content:   content,
+		chunkSize: chunkSize,
+		stored:    make(map[int][]byte),
+		puts:      make(map[int]int),
+	}
+
+	api := http.NewServeMux()
+	api.HandleFunc("POST /assets/multipart-uploads", func(w http.ResponseWriter, r *http.Request) {
+		var request models.UploadSessionCreateRequest
+		if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+			t.Errorf("decode session request: %v", err)
+		}
+		if request.TotalSize != int64(len(s.content)) {
+			t.Errorf("total_size = %d, want %d", request.TotalSize, len(s.content))
+		}
+		s.mu.Lock()
+		s.sessions++
+		s.mu.Unlock()
+		writeJSON(w, models.UploadSession{
+			UploadID:    "upload-1",
+			AssetID:     "asset-1",
+			ChunkSize:   int64(s.chunkSize),
+			TotalChunks: s.totalChunks(),
+			UploadURLs:  s.urls(1, s.totalChunks()),
+		})
+	})
+	api.HandleFunc("POST /assets/multipart-uploads/upload-1/presigned-urls", func(w http.ResponseWriter, r *http.Request) {
+		var request struct{ Start, Count int }
+		if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+			t.Errorf("decode presigned-urls request: %v", err)
+		}
+		writeJSON(w, map[string]any{"upload_urls": s.urls(request.Start, request.Count)})
+	})
+	api.HandleFunc("GET /assets/multipart-uploads/upload-1", func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		if s.session != 0 {
+			http.Error(w, `{"message":"session lookup failed"}`, s.session)
+			return
+		}
+		status := models.UploadSessionStatus{UploadID: "upload-1", AssetID: "asset-1", Status: "active", TotalChunks: s.totalChunks()}
+		if s.state != "" {
+			status.Status = s.state
+		}
+		for index := range s.stored {
+			status.CompletedChunks = append(status.CompletedChunks,
+				models.CompletedChunk{ChunkIndex: index, Proof: etag(index), ProofType: "etag"})
+		}
+		writeJSON(w, status)
+	})
+	api.HandleFunc("POST /assets/multipart-uploads/upload-1", func(w http.ResponseWriter, r *http.Request) {
+		var request struct {
+			CompletedChunks []models.CompletedChunk `json:"completed_chunks"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+			t.Errorf("decode report: %v", err)
+		}
+		s.mu.Lock()
+		s.reported = request.CompletedChunks
+		s.mu.Unlock()
+		writeJSON(w, map[string]string{"status": "completed"})
+	})
+	api.HandleFunc("GET /assets/asset-1", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, models.Asset{ID: "asset-1", Status: "processing", Filename: "clip.mp4"})
+	})
+	s.api = httptest.NewServer(api)
+	t.Cleanup(s.api.Close)
+
+	storage := http.NewServeMux()
+	storage.HandleFunc("PUT /chunks/{index}", func(w http.ResponseWriter, r *http.Request) {
+		if key := r.Header.Get("X-API-KEY"); key != "" {
+			t.Errorf("storage received API key %q", key)
+		}
+		index, _ := strconv.Atoi(r.PathValue("index"))
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			t.Errorf("read chunk %d: %v", index, err)
+		}
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		if index == s.failing {
+			http.Error(w, "storage unavailable", http.StatusServiceUnavailable)
+			return
+		}
+		s.stored[index] = body
+		s.puts[index]++
+		w.Header().Set("ETag", etag(index))
+	})
+	s.storageHandler = storage
+	s.restartStorage()
+	return s
+}
+
+// restartStorage starts a new storage server once every request to the current
+// one has been handled, as after a crash. Fresh URLs point to the new server.
+func (s *uploadServer) restartStorage() {
+	if s.storage != nil {
+		s.storage.Close()
+	}
+	s.storage = httptest.NewServer(s.storageHandler)
+	s.t.Cleanup(s.storage.Close)
+}
+
+func (s *uploadServer) totalChunks() int {
+	return (len(s.content) + s.chunkSize - 1) / s.chunkSize
+}
+
+func (s *uploadServer) urls(start, count int) []models.UploadURL {
+	var urls []models.UploadURL
+	for index := start; index < start+count && index <= s.totalChunks(); index++ {
+		urls = append(urls, models.UploadURL{ChunkIndex: index, URL: fmt.Sprintf("%s/chunks/%d?signature=secret", s.storage.URL, index)})
+	}
+	return urls
+}
+
+// assembled returns the stored chunks joined in order.
+func (s *uploadServer) assembled() []byte {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var content []byte
+	for index := 1; index <= s.totalChunks(); index++ {
+		content = append(content, s.stored[index]...)
+	}
+	return content
+}
+
+func etag(index int) string {
+	return fmt.Sprintf(`"etag-%d"`, index)
+}
+
+func writeJSON(w http.ResponseWriter, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func TestUploadResumesAfterFailedChunk(t *testing.T) {
+	content := []byte("0123456789abcdefghij")
+	server := newUploadServer(t, content, 3)
+	server.failing = 3
+
+	var middlewarePaths []string
+	var pathsMu sync.Mutex
+	c := client.NewClient(&client.Options{
+		BaseURL: server.api.URL,
+		APIKey:  "test-key",
+		Middlewares: []client.Middleware{func(next http.RoundTripper) http.RoundTripper {
+			return client.RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+				pathsMu.Lock()
+				middlewarePaths = append(middlewarePaths, req.URL.Path)
+				pathsMu.Unlock()
+				return next.RoundTrip(req)
+			})
+		}},
+	})
+	uploads := wrappers.NewUploadsWrapper(c.Uploads)
+
+	stateFile := filepath.Join(t.TempDir(), "upload.json")
+	options := &wrappers.UploadOptions{
+		Filename:     "clip.mp4",
+		Concurrency:  2,
+		ChunkRetries: 1,
+		RetryBackoff: time.Millisecond,
+		StateFile:    stateFile,
+	}
+	source := models.MediaFromBytes(content, "clip.mp4")
+
+	if _, err := uploads.Upload(context.Background(), source, options); err == nil {
+		t.Fatal("first upload succeeded despite a failing chunk")
+	}
+	state, err := wrappers.LoadUploadState(stateFile)
+	if err != nil {
+		t.Fatalf("state file not kept after the failure: %v", err)
+	}
+	if len(state.Completed) == 0 {
+		t.Fatal("state file records no completed chunk")
+	}
+	for _, chunk := range state.Completed {
+		if chunk.ChunkIndex == 3 {
+			t.Fatal("state file records the failing chunk as completed")
+		}
+	}
+
+	// Chunk uploads cancelled by the failure may still be in flight; wait for them.
+	server.restartStorage()
+	// The storage lost a chunk the state file records, so it must be uploaded again.
+	lost := state.Completed[0].ChunkIndex
+	server.mu.Lock()
+	server.failing = 0
+	delete(server.stored, lost)
+	server.puts[lost] = 0
+	server.mu.Unlock()
+
+	asset, err := uploads.Upload(context.Background(), source, options)
+	if err != nil {
+		t.Fatalf("resumed upload: %v", err)
+	}
+	if asset.ID != "asset-1" {
+		t.Errorf("asset ID = %q, want asset-1", asset.ID)
+	}
+
+	if got := server.assembled(); !bytes.Equal(got, content) {
+		t.Errorf("stored content = %q, want %q", got, content)
+	}
+	for index := 1; index <= server.totalChunks(); index++ {
+		if server.puts[index] != 1 {
+			t.Errorf("chunk %d stored %d times, want once", index, server.puts[index])
+		}
+	}
+	if len(server.reported) != server.totalChunks() {
+		t.Fatalf("reported %d chunks, want %d", len(server.reported), server.totalChunks())
+	}
+	for i, chunk := range server.reported {
+		if chunk.ChunkIndex != i+1 || chunk.Proof != etag(i+1) {
+			t.Errorf("reported chunk %d = %+v", i, chunk)
+		}
+	}
+	if _, err := wrappers.LoadUploadState(stateFile); err == nil {
+		t.Error("state file kept after the upload completed")
+	}
+
+	for _, path := range middlewarePaths {
+		if strings.HasPrefix(path, "/chunks/") {
+			t.Fatalf("chunk upload %s went through the client middlewares", path)
+		}
+	}
+}
+
+func TestUploadResumeOnlyRestartsLostSessions(t *testing.T) {
+	tests := []struct {
+		name        string
+		status      int
+		state       string
+		wantErr     bool
+		wantRestart bool
+	}{
+		{name: "not found", status: http.StatusNotFound, wantRestart: true},
+		{name: "expired", state: "expired", wantRestart: true},
+		{name: "server error", status: http.StatusServiceUnavailable, wantErr: true},
+		{name: "forbidden", status: http.StatusForbidden, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			content := []byte("0123456789abcdefghij")
+			server := newUploadServer(t, content, 3)
+			server.failing = 3
+
+			c := client.NewClient(&client.Options{BaseURL: server.api.URL, APIKey: "test-key"})
+			uploads := wrappers.NewUploadsWrapper(c.Uploads)
+			stateFile := filepath.Join(t.TempDir(), "upload.json")
+			options := &wrappers.UploadOptions{
+				Filename:     "clip.mp4",
+				RetryBackoff: time.Millisecond,
+				StateFile:    stateFile,
+			}
+			source := models.MediaFromBytes(content, "clip.mp4")
+
+			if _, err := uploads.Upload(context.Background(), source, options); err == nil {
+				t.Fatal("first upload succeeded despite a failing chunk")
+			}
+			saved, err := os.ReadFile(stateFile)
+			if err != nil {
+				t.Fatalf("state file not kept after the failure: %v", err)
+			}
+
+			server.restartStorage()
+			server.mu.Lock()
+			server.failing = 0
+			server.session = tt.status
+			server.state = tt.state
+			server.mu.Unlock()
+
+			_, err = uploads.Upload(context.Background(), source, options)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("upload succeeded although the session could not be looked up")
+				}
+				if current, _ := os.ReadFile(stateFile); !bytes.Equal(current, saved) {
+					t.Errorf("state file changed to %s, want %s", current, saved)
+				}
+			} else if err != nil {
+				t.Fatalf("upload after a lost session: %v", err)
+			}
+			if restarted := server.sessions == 2; restarted != tt.wantRestart {
+				t.Errorf("created %d sessions, want a new one: %v", server.sessions, tt.wantRestart)
+			}
+		})
+	}
+}
@@ -0,0 +1,217 @@
+package wrappers
+
+import (
+	"context"
+	"log/slog"
+	"maps"
+
+	"github.com/favourthemaster/twelvelabs-go-sdk/pkg/errors"
+	"github.com/favourthemaster/twelvelabs-go-sdk/pkg/models"
+)
+
+// RetrieveWithOptions gets a video in an index together with its transcription,
+// visual text or embeddings, as selected by opts.
+//
+// Example:
+//
+//	video, err := client.Indexes.Videos.RetrieveWithOptions(ctx, indexID, videoID, &models.VideoRetrieveOptions{
+//	    EmbeddingOptions: []string{"visual-text", "audio"},
+//	    Transcription:    true,
+//	    VisualText:       true,
+//	})
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	for _, line := range video.Transcription {
+//	    fmt.Printf("[%.1f-%.1fs] %s\n", line.Start, line.End, line.Value)
+//	}
+func (ivw *IndexesVideosWrapper) RetrieveWithOptions(ctx context.Context, indexID, videoID string, opts *models.VideoRetrieveOptions) (*models.Video, error) {
+	return ivw.service.RetrieveVideoWithOptions(ctx, indexID, videoID, opts)
+}
+
+// Stream gets the HLS stream of a video. It returns an error wrapping
+// errors.ErrNotFound if the video was uploaded without video streaming enabled.
+func (ivw *IndexesVideosWrapper) Stream(ctx context.Context, indexID, videoID string) (*models.VideoHLS, error) {
+	video, err := ivw.service.RetrieveVideo(ctx, indexID, videoID)
+	if err != nil {
+		return nil, err
+	}
+	if video.HLS == nil {
+		return nil, errors.NewNotFoundError("video " + videoID + " has no HLS stream; enable video streaming when uploading it")
+	}
+	return video.HLS, nil
+}
+
+// Thumbnails gets the thumbnails generated with the HLS stream of a video.
+func (ivw *IndexesVideosWrapper) Thumbnails(ctx context.Context, indexID, videoID string) ([]string, error) {
+	stream, err := ivw.Stream(ctx, indexID, videoID)
+	if err != nil {
+		return nil, err
+	}
+	return stream.ThumbnailURLs, nil
+}
+
+// Thumbnail gets the URL of a thumbnail of a video taken at the given time in seconds.
+func (ivw *IndexesVideosWrapper) Thumbnail(ctx context.Context, indexID, videoID string, at float64) (string, error) {
+	return ivw.service.RetrieveVideoThumbnail(ctx, indexID, videoID, at)
+}
+
+// VideoUpdateItem is the update of a single video in UpdateBulk.
+type VideoUpdateItem struct {
+	// VideoID identifies the video to update.
+	VideoID string
+	// Update holds the new file name and user metadata of the video.
+	Update models.VideoUpdateRequest
+}
+
+// UpdateBulkRequest represents a request for updating the metadata of many videos in an index.
+//
+// Videos can be given as a plain list of VideoIDs, which all receive Defaults, or as
+// Items carrying the update of each video. UserMetadata of Defaults is merged into
+// every update key by key, with the item's values taking precedence.
+type UpdateBulkRequest struct {
+	// IndexID is the index holding the videos
+	IndexID string
+	// VideoIDs contains videos that receive Defaults as is (optional)
+	VideoIDs []string
+	// Items contains the update of each video (optional)
+	Items []VideoUpdateItem
+	// Defaults holds user metadata shared by every video (optional). Its file name is ignored.
+	Defaults *models.VideoUpdateRequest
+	// Workers is the number of videos updated concurrently (optional, defaults to 4)
+	Workers int
+	// Mode selects best-effort (default) or fail-fast processing (optional)
+	Mode BulkMode
+}
+
+// DeleteBulkRequest represents a request for deleting many videos from an index.
+type DeleteBulkRequest struct {
+	// IndexID is the index holding the videos
+	IndexID string
+	// VideoIDs contains the videos to delete
+	VideoIDs []string
+	// Workers is the number of videos deleted concurrently (optional, defaults to 4)
+	Workers int
+	// Mode selects best-effort (default) or fail-fast processing (optional)
+	Mode BulkMode
+}
+
+// BulkVideoResult is the outcome of a single video of UpdateBulk or DeleteBulk.
+type BulkVideoResult struct {
+	// VideoID identifies the video.
+	VideoID string
+	// Video is the updated video as returned by the API. It is nil for deletions.
+	Video *models.Video
+	// Err is why the video was not updated or deleted. Videos skipped because the
+	// batch stopped early carry the context error or ErrBulkAborted.
+	Err error
+}
+
+// UpdateBulk updates the metadata of many videos in an index concurrently.
+//
+// Failure handling works as in TasksWrapper.CreateBulk.
+//
+// Returns:
+//   - One result per video, VideoIDs first, then Items, in the order given
+//   - The failures of all videos combined with errors.Join, each prefixed with its
+//     video ID, plus the context error if ctx was cancelled; nil if every video was updated
+//
+// Example:
+//
+//	results, err := client.Indexes.Videos.UpdateBulk(ctx, &wrappers.UpdateBulkRequest{
+//	    IndexID:  "your_index_id",
+//	    VideoIDs: videoIDs,
+//	    Defaults: &models.VideoUpdateRequest{
+//	        UserMetadata: map[string]string{"catalogue": "spring-2025"},
+//	    },
+//	})
+//	for _, result := range results {
+//	    if result.Err != nil {
+//	        fmt.Printf("%s failed: %v\n", result.VideoID, result.Err)
+//	    }
+//	}
+func (ivw *IndexesVideosWrapper) UpdateBulk(ctx context.Context, request *UpdateBulkRequest) ([]BulkVideoResult, error) {
+	if request.IndexID == "" {
+		return nil, errors.NewValidationError("IndexID must be provided")
+	}
+	if len(request.VideoIDs) == 0 && len(request.Items) == 0 {
+		return nil, errors.NewValidationError("either VideoIDs or Items must be provided")
+	}
+
+	items := make([]VideoUpdateItem, 0, len(request.VideoIDs)+len(request.Items))
+	for _, videoID := range request.VideoIDs {
+		items = append(items, VideoUpdateItem{VideoID: videoID})
+	}
+	items = append(items, request.Items...)
+	if request.Defaults != nil && len(request.Defaults.UserMetadata) > 0 {
+		for i := range items {
+			metadata := maps.Clone(request.Defaults.UserMetadata)
+			maps.Copy(metadata, items[i].Update.UserMetadata)
+			items[i].Update.UserMetadata = metadata
+		}
+	}
+
+	results := make([]BulkVideoResult, len(items))
+	for i, item := range items {
+		results[i].VideoID = item.VideoID
+	}
+	run := &bulkRun{
+		workers: request.Workers,
+		mode:    request.Mode,
+		source:  func(i int) string { return items[i].VideoID },
+		do: func(ctx context.Context, i int) error {
+			video, err := ivw.service.UpdateVideo(ctx, request.IndexID, items[i].VideoID, &items[i].Update)
+			results[i].Video = video
+			return err
+		},
+		onFailure: func(i int, err error) {
+			ivw.service.Client.Logger().Warn("failed to update video",
+				slog.String("video_id", items[i].VideoID), slog.Any("error", err))
+		},
+	}
+	return ivw.runBulk(ctx, run, results)
+}
+
+// DeleteBulk deletes many videos from an index concurrently.
+//
+// Failure handling works as in TasksWrapper.CreateBulk.
+//
+// Returns:
+//   - One result per video, in the order given
+//   - The failures of all videos combined with errors.Join, each prefixed with its
+//     video ID, plus the context error if ctx was cancelled; nil if every video was deleted
+func (ivw *IndexesVideosWrapper) DeleteBulk(ctx context.Context, request *DeleteBulkRequest) ([]BulkVideoResult, error) {
+	if request.IndexID == "" {
+		return nil, errors.NewValidationError("IndexID must be provided")
+	}
+	if len(request.VideoIDs) == 0 {
+		return nil, errors.NewValidationError("VideoIDs must be provided")
+	}
+
+	results := make([]BulkVideoResult, len(request.VideoIDs))
+	for i, videoID := range request.VideoIDs {
+		results[i].VideoID = videoID
+	}
+	run := &bulkRun{
+		workers: request.Workers,
+		mode:    request.Mode,
+		source:  func(i int) string { return request.VideoIDs[i] },
+		do: func(ctx context.Context, i int) error {
+			return ivw.service.DeleteVideo(ctx, request.IndexID, request.VideoIDs[i])
+		},
+		onFailure: func(i int, err error) {
+			ivw.service.Client.Logger().Warn("failed to delete video",
+				slog.String("video_id", request.VideoIDs[i]), slog.Any("error", err))
+		},
+	}
+	return ivw.runBulk(ctx, run, results)
+}
+
+// runBulk runs a bulk operation over results and records the error of each video.
+func (ivw *IndexesVideosWrapper) runBulk(ctx context.Context, run *bulkRun, results []BulkVideoResult) ([]BulkVideoResult, error) {
+	errs, err := run.run(ctx, len(results))
+	for i := range results {
+		results[i].Err = errs[i]
+	}
+	return results, err
+}
@@ -0,0 +1,198 @@
+package wrappers_test
+
+import (
+	"context"
+	"encoding/json"
+	stderrors "errors"
+	"maps"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/favourthemaster/twelvelabs-go-sdk/pkg/client"
+	"github.com/favourthemaster/twelvelabs-go-sdk/pkg/errors"
+	"github.com/favourthemaster/twelvelabs-go-sdk/pkg/models"
+	"github.com/favourthemaster/twelvelabs-go-sdk/pkg/wrappers"
+)
+
+// videoServer fakes the video endpoints of index-1. Videos whose ID starts with
+// "bad" cannot be updated or deleted, and only "streamed" has an HLS stream.
+type videoServer struct {
+	mu       sync.Mutex
+	updates  map[string]models.VideoUpdateRequest
+	requests []string // video IDs in the order they were requested
+}
+
+func newVideosWrapper(t *testing.T) (*wrappers.IndexesVideosWrapper, *videoServer) {
+	s := &videoServer{updates: make(map[string]models.VideoUpdateRequest)}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/indexes/index-1/videos/{id}", func(w http.ResponseWriter, r *http.Request) {
+		videoID := r.PathValue("id")
+		s.mu.Lock()
+		s.requests = append(s.requests, videoID)
+		s.mu.Unlock()
+		if r.Method != http.MethodGet && strings.HasPrefix(videoID, "bad") {
+			w.WriteHeader(http.StatusBadRequest)
+			writeJSON(w, map[string]string{"code": "parameter_invalid", "message": "invalid video"})
+			return
+		}
+
+		switch r.Method {
+		case http.MethodPut:
+			var update models.VideoUpdateRequest
+			if err := json.NewDecoder(r.Body).Decode(&update); err != nil {
+				t.Errorf("decode update of %s: %v", videoID, err)
+			}
+			s.mu.Lock()
+			s.updates[videoID] = update
+			s.mu.Unlock()
+			writeJSON(w, models.Video{ID: videoID})
+		case http.MethodDelete:
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			video := models.Video{ID: videoID}
+			if videoID == "streamed" {
+				video.HLS = &models.VideoHLS{VideoURL: "https://stream.example.com/streamed.m3u8", Status: "COMPLETE"}
+			}
+			writeJSON(w, video)
+		}
+	})
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	c := client.NewClient(&client.Options{BaseURL: server.URL, APIKey: "test-key"})
+	return wrappers.NewIndexesVideosWrapper(c.Indexes), s
+}
+
+func TestUpdateBulkMergesDefaultMetadata(t *testing.T) {
+	videos, server := newVideosWrapper(t)
+
+	results, err := videos.UpdateBulk(context.Background(), &wrappers.UpdateBulkRequest{
+		IndexID:  "index-1",
+		VideoIDs: []string{"video-1"},
+		Items: []wrappers.VideoUpdateItem{{
+			VideoID: "video-2",
+			Update: models.VideoUpdateRequest{
+				FileName:     "renamed.mp4",
+				UserMetadata: map[string]string{"season": "summer", "reviewed": "true"},
+			},
+		}},
+		Defaults: &models.VideoUpdateRequest{
+			FileName:     "ignored.mp4",
+			UserMetadata: map[string]string{"catalogue": "2025", "season": "spring"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("UpdateBulk: %v", err)
+	}
+	for i, videoID := range []string{"video-1", "video-2"} {
+		if results[i].VideoID != videoID || results[i].Err != nil || results[i].Video == nil || results[i].Video.ID != videoID {
+			t.Errorf("result %d = %+v, want the updated %s", i, results[i], videoID)
+		}
+	}
+
+	want := map[string]models.VideoUpdateRequest{
+		"video-1": {UserMetadata: map[string]string{"catalogue": "2025", "season": "spring"}},
+		"video-2": {
+			FileName:     "renamed.mp4",
+			UserMetadata: map[string]string{"catalogue": "2025", "season": "summer", "reviewed": "true"},
+		},
+	}
+	for videoID, update := range want {
+		got := server.updates[videoID]
+		if got.FileName != update.FileName || !maps.Equal(got.UserMetadata, update.UserMetadata) {
+			t.Errorf("%s updated with %+v, want %+v", videoID, got, update)
+		}
+	}
+}
+
+func TestBulkVideoModes(t *testing.T) {
+	videoIDs := []string{"video-1", "bad-1", "video-2", "video-3"}
+	operations := map[string]func(*wrappers.IndexesVideosWrapper, wrappers.BulkMode) ([]wrappers.BulkVideoResult, error){
+		"update": func(videos *wrappers.IndexesVideosWrapper, mode wrappers.BulkMode) ([]wrappers.BulkVideoResult, error) {
+			return videos.UpdateBulk(context.Background(), &wrappers.UpdateBulkRequest{
+				IndexID:  "index-1",
+				VideoIDs: videoIDs,
+				Defaults: &models.VideoUpdateRequest{UserMetadata: map[string]string{"reviewed": "true"}},
+				Workers:  1,
+				Mode:     mode,
+			})
+		},
+		"delete": func(videos *wrappers.IndexesVideosWrapper, mode wrappers.BulkMode) ([]wrappers.BulkVideoResult, error) {
+			return videos.DeleteBulk(context.Background(), &wrappers.DeleteBulkRequest{
+				IndexID:  "index-1",
+				VideoIDs: videoIDs,
+				Workers:  1,
+				Mode:     mode,
+			})
+		},
+	}
+
+	for name, operation := range operations {
+		t.Run(name+"/best effort", func(t *testing.T) {
+			videos, server := newVideosWrapper(t)
+			results, err := operation(videos, wrappers.BulkBestEffort)
+			if !stderrors.Is(err, errors.ErrBadRequest) {
+				t.Errorf("error = %v, want the failure of bad-1", err)
+			}
+			if len(results) != len(videoIDs) {
+				t.Fatalf("got %d results, want %d", len(results), len(videoIDs))
+			}
+			for i, result := range results {
+				if result.VideoID != videoIDs[i] {
+					t.Errorf("result %d is for %s, want %s", i, result.VideoID, videoIDs[i])
+				}
+				if failed := result.Err != nil; failed != (result.VideoID == "bad-1") {
+					t.Errorf("%s: error = %v", result.VideoID, result.Err)
+				}
+			}
+			if !slices.Equal(server.requests, videoIDs) {
+				t.Errorf("requested %v, want every video", server.requests)
+			}
+		})
+
+		t.Run(name+"/fail fast", func(t *testing.T) {
+			videos, server := newVideosWrapper(t)
+			results, err := operation(videos, wrappers.BulkFailFast)
+			if !stderrors.Is(err, errors.ErrBadRequest) {
+				t.Errorf("error = %v, want the failure of bad-1", err)
+			}
+			if results[0].Err != nil {
+				t.Errorf("video-1: error = %v", results[0].Err)
+			}
+			if !stderrors.Is(results[1].Err, errors.ErrBadRequest) {
+				t.Errorf("bad-1: error = %v, want a bad request", results[1].Err)
+			}
+			for _, result := range results[2:] {
+				if !stderrors.Is(result.Err, wrappers.ErrBulkAborted) {
+					t.Errorf("%s: error = %v, want %v", result.VideoID, result.Err, wrappers.ErrBulkAborted)
+				}
+			}
+			if !slices.Equal(server.requests, videoIDs[:2]) {
+				t.Errorf("requested %v, want nothing after bad-1", server.requests)
+			}
+		})
+	}
+}
+
+func TestStream(t *testing.T) {
+	videos, _ := newVideosWrapper(t)
+
+	stream, err := videos.Stream(context.Background(), "index-1", "streamed")
+	if err != nil {
+		t.Fatalf("Stream: %v", err)
+	}
+	if stream.VideoURL != "https://stream.example.com/streamed.m3u8" {
+		t.Errorf("stream URL = %q", stream.VideoURL)
+	}
+
+	if _, err := videos.Stream(context.Background(), "index-1", "video-1"); !stderrors.Is(err, errors.ErrNotFound) {
+		t.Errorf("Stream of a video without HLS = %v, want %v", err, errors.ErrNotFound)
+	}
+	if _, err := videos.Thumbnails(context.Background(), "index-1", "video-1"); !stderrors.Is(err, errors.ErrNotFound) {
+		t.Errorf("Thumbnails of a video without HLS = %v, want %v", err, errors.ErrNotFound)
+	}
+}
@@ -0,0 +1,326 @@
+package wrappers
+
+import (
+	"context"
+	stderrors "errors"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/favourthemaster/twelvelabs-go-sdk/pkg/errors"
+	"github.com/favourthemaster/twelvelabs-go-sdk/pkg/models"
+	"github.com/favourthemaster/twelvelabs-go-sdk/pkg/poll"
+	"github.com/favourthemaster/twelvelabs-go-sdk/pkg/telemetry"
+)
+
+// taskListPageLimit is the largest page the tasks listing returns.
+const taskListPageLimit = 50
+
+// WaitForManyOptions configures WaitForAll and WaitForAny. All fields are optional.
+type WaitForManyOptions struct {
+	// PollInterval is the delay after the first polling round, grown with backoff
+	// while no task changes status. Defaults to 5s.
+	PollInterval time.Duration
+	// MaxInterval caps the delay between polling rounds. Defaults to 30s.
+	MaxInterval time.Duration
+	// MaxErrors is the number of consecutive failed retrievals tolerated per task.
+	// Defaults to 3.
+	MaxErrors int
+	// Concurrency is the number of tasks retrieved in parallel. Defaults to 4.
+	Concurrency int
+	// Events receives an event whenever the status of a task changes, including
+	// the first status seen. Sends block until the event is received or ctx is
+	// done, so drain the channel concurrently. The channel belongs to the caller
+	// and is never closed; no event is sent once the wait has returned.
+	Events chan<- TaskEvent
+}
+
+// TaskEvent reports a status change of a task being waited on.
+type TaskEvent struct {
+	// TaskID identifies the task.
+	TaskID string
+	// PreviousStatus is the status seen in the previous round, empty for the first.
+	PreviousStatus models.TaskStatus
+	// Task is the task as last retrieved.
+	Task *models.Task
+}
+
+// TaskWaitResult is the outcome of waiting on a single task.
+type TaskWaitResult struct {
+	// Task is the task in its final state, or as last seen if the wait stopped early.
+	// A task that failed to index is returned with its failed status and no Err.
+	Task *models.Task
+	// Err is why the task could not be followed to a final state.
+	Err error
+}
+
+// WaitForAll waits until every task reaches a final status (ready, failed or error).
+// Tasks are polled in rounds: tasks of the same index are refreshed with a single
+// list call where possible, and the others are retrieved individually by a pool
+// of options.Concurrency workers.
+//
+// The wait is bounded by ctx alone; set a deadline on it to limit the total time.
+//
+// Parameters:
+//   - taskIDs: The tasks to wait for
+//   - options: Polling options; nil uses the defaults
+//
+// Returns:
+//   - One result per task ID
+//   - The errors of all tasks combined with errors.Join, each prefixed with its task
+//     ID, plus the context error if ctx ended first; nil if every task finished
+//
+// Example:
+//
+//	events := make(chan wrappers.TaskEvent)
+//	go func() {
+//	    for event := range events {
+//	        fmt.Printf("%s: %s -> %s\n", event.TaskID, event.PreviousStatus, event.Task.Status)
+//	    }
+//	}()
+//	ctx, cancel := context.WithTimeout(ctx, 30*time.Minute)
+//	defer cancel()
+//	results, err := client.Tasks.WaitForAll(ctx, taskIDs, &wrappers.WaitForManyOptions{Events: events})
+//	close(events)
+func (tw *TasksWrapper) WaitForAll(ctx context.Context, taskIDs []string, options *WaitForManyOptions) (_ map[string]TaskWaitResult, err error) {
+	ctx, span := tw.service.Client.Telemetry().StartSpan(ctx, "twelvelabs.tasks.wait_for_all")
+	defer func() { telemetry.EndSpan(span, err) }()
+
+	results := tw.waitForMany(ctx, taskIDs, options, false)
+
+	var errs []error
+	for _, id := range taskIDs {
+		if result := results[id]; result.Err != nil && !isContextError(result.Err) {
+			errs = append(errs, fmt.Errorf("%s: %w", id, result.Err))
+		}
+	}
+	if err := ctx.Err(); err != nil {
+		errs = append(errs, err)
+	}
+	return results, stderrors.Join(errs...)
+}
+
+// WaitForAny waits until one of the tasks reaches a final status and returns it.
+// Polling works as in WaitForAll and stops as soon as a task finishes.
+//
+// Returns:
+//   - The first task to finish; if several finish in the same round, the first in taskIDs
+//   - error if ctx ends first, or if none of the tasks can be followed
+func (tw *TasksWrapper) WaitForAny(ctx context.Context, taskIDs []string, options *WaitForManyOptions) (_ *models.Task, err error) {
+	ctx, span := tw.service.Client.Telemetry().StartSpan(ctx, "twelvelabs.tasks.wait_for_any")
+	defer func() { telemetry.EndSpan(span, err) }()
+
+	if len(taskIDs) == 0 {
+		return nil, errors.NewValidationError("at least one task ID must be provided")
+	}
+
+	results := tw.waitForMany(ctx, taskIDs, options, true)
+
+	var errs []error
+	for _, id := range taskIDs {
+		result := results[id]
+		if result.Err == nil && result.Task != nil && result.Task.Status.IsTerminal() {
+			return result.Task, nil
+		}
+		if result.Err != nil && !isContextError(result.Err) {
+			errs = append(errs, fmt.Errorf("%s: %w", id, result.Err))
+		}
+	}
+	if err := ctx.Err(); err != nil {
+		errs = append(errs, err)
+	}
+	return nil, stderrors.Join(errs...)
+}
+
+// waitForMany polls the tasks until they are all done, or until any is done when
+// first is set. Tasks still pending when ctx ends carry the context error.
+func (tw *TasksWrapper) waitForMany(ctx context.Context, taskIDs []string, options *WaitForManyOptions, first bool) map[string]TaskWaitResult {
+	opts := WaitForManyOptions{}
+	if options != nil {
+		opts = *options
+	}
+	if opts.Concurrency <= 0 {
+		opts.Concurrency = 4
+	}
+	if opts.MaxErrors <= 0 {
+		opts.MaxErrors = poll.DefaultMaxErrors
+	}
+
+	results := make(map[string]TaskWaitResult, len(taskIDs))
+	pending := make(map[string]*models.Task, len(taskIDs))
+	failures := make(map[string]int)
+	for _, id := range taskIDs {
+		pending[id] = nil
+	}
+
+	// Each round reports the number of status changes as its state, so the poller
+	// backs off while nothing happens and stops once the wait is over.
+	poller := &poll.Poller[bool]{
+		Interval:    opts.PollInterval,
+		MaxInterval: opts.MaxInterval,
+		Done:        func(done bool) bool { return done },
+	}
+	var changes int
+	poller.State = func(bool) string { return fmt.Sprint(changes) }
+
+	_, _ = poller.Poll(ctx, func(ctx context.Context) (bool, error) {
+		tasks, errs := tw.pollTasks(ctx, pending, opts.Concurrency)
+		if err := ctx.Err(); err != nil {
+			return false, err
+		}
+
+		finished := false
+		for _, id := range taskIDs {
+			previous, ok := pending[id]
+			if !ok {
+				continue
+			}
+			if err := errs[id]; err != nil {
+				failures[id]++
+				if failures[id] < opts.MaxErrors && poll.Transient(err) {
+					continue
+				}
+				tw.service.Client.Logger().Warn("failed to retrieve task",
+					slog.String("task_id", id), slog.Any("error", err))
+				results[id] = TaskWaitResult{Task: previous, Err: err}
+				delete(pending, id)
+				continue
+			}
+			failures[id] = 0
+			task := tasks[id]
+			if previous == nil || previous.Status != task.Status {
+				changes++
+				event := TaskEvent{TaskID: id, Task: task}
+				if previous != nil {
+					event.PreviousStatus = previous.Status
+				}
+				if !sendTaskEvent(ctx, opts.Events, event) {
+					return false, ctx.Err()
+				}
+			}
+			pending[id] = task
+			if task.Status.IsTerminal() {
+				results[id] = TaskWaitResult{Task: task}
+				delete(pending, id)
+				finished = true
+			}
+		}
+		return len(pending) == 0 || (first && finished), nil
+	})
+
+	for id, task := range pending {
+		err := ctx.Err()
+		if err == nil {
+			err = context.Canceled
+		}
+		results[id] = TaskWaitResult{Task: task, Err: err}
+	}
+	return results
+}
+
+// sendTaskEvent delivers event unless ctx ends first. It reports whether the event was sent.
+func sendTaskEvent(ctx context.Context, events chan<- TaskEvent, event TaskEvent) bool {
+	if events == nil {
+		return true
+	}
+	select {
+	case events <- event:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
+
+// pollTasks refreshes the pending tasks. Tasks whose index is known are looked up
+// in one listing per index when that index has several pending tasks; the rest
+// are retrieved one by one with a pool of workers.
+func (tw *TasksWrapper) pollTasks(ctx context.Context, pending map[string]*models.Task, concurrency int) (map[string]*models.Task, map[string]error) {
+	tasks := make(map[string]*models.Task, len(pending))
+	errs := make(map[string]error)
+
+	byIndex := make(map[string][]string)
+	for id, task := range pending {
+		if task != nil && task.IndexID != "" {
+			byIndex[task.IndexID] = append(byIndex[task.IndexID], id)
+		}
+	}
+	for indexID, ids := range byIndex {
+		if len(ids) >= 2 {
+			tw.listTasks(ctx, indexID, ids, pending, tasks)
+		}
+	}
+
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	jobs := make(chan string)
+	for range min(concurrency, len(pending)) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for id := range jobs {
+				task, err := tw.service.Retrieve(ctx, id)
+				mu.Lock()
+				if err != nil {
+					errs[id] = err
+				} else {
+					tasks[id] = task
+				}
+				mu.Unlock()
+			}
+		}()
+	}
+feed:
+	for id := range pending {
+		if _, ok := tasks[id]; ok {
+			continue
+		}
+		select {
+		case jobs <- id:
+		case <-ctx.Done():
+			break feed
+		}
+	}
+	close(jobs)
+	wg.Wait()
+	return tasks, errs
+}
+
+// listTasks looks up the pending tasks ids of an index in the tasks listing and
+// stores them in tasks. Only tasks created since the oldest of them are listed,
+// and pages are followed until all of them are found. Tasks that cannot be
+// listed are left out, to be retrieved one by one.
+func (tw *TasksWrapper) listTasks(ctx context.Context, indexID string, ids []string, pending, tasks map[string]*models.Task) {
+	opts := &models.TaskListOptions{IndexID: indexID, PageLimit: taskListPageLimit}
+	remaining := make(map[string]bool, len(ids))
+	var oldest time.Time
+	bounded := true
+	for _, id := range ids {
+		remaining[id] = true
+		created, err := time.Parse(time.RFC3339, pending[id].CreatedAt)
+		if err != nil {
+			bounded = false
+			continue
+		}
+		if oldest.IsZero() || created.Before(oldest) {
+			oldest = created
+		}
+	}
+	if bounded {
+		opts.CreatedAt = &models.TimeRange{From: oldest}
+	}
+
+	for task, err := range tw.service.Iterate(ctx, opts) {
+		if err != nil {
+			return
+		}
+		if !remaining[task.ID] {
+			continue
+		}
+		tasks[task.ID] = &task
+		delete(remaining, task.ID)
+		if len(remaining) == 0 {
+			return
+		}
+	}
+}
@@ -0,0 +1,74 @@
+package wrappers_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/favourthemaster/twelvelabs-go-sdk/pkg/client"
+	"github.com/favourthemaster/twelvelabs-go-sdk/pkg/models"
+	"github.com/favourthemaster/twelvelabs-go-sdk/pkg/wrappers"
+)
+
+func TestWaitForAllFollowsListingPages(t *testing.T) {
+	created := map[string]string{
+		"task-1": "2026-01-02T10:00:00Z",
+		"task-2": "2026-01-02T09:00:00Z",
+		"task-3": "2026-01-02T11:00:00Z",
+	}
+	task := func(id string, status models.TaskStatus) models.Task {
+		return models.Task{ID: id, Status: status, IndexID: "index-1", CreatedAt: created[id]}
+	}
+
+	var retrieves atomic.Int32
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /tasks/{id}", func(w http.ResponseWriter, r *http.Request) {
+		retrieves.Add(1)
+		writeJSON(w, task(r.PathValue("id"), models.TaskStatusIndexing))
+	})
+	mux.HandleFunc("GET /tasks", func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		if got := query.Get("index_id"); got != "index-1" {
+			t.Errorf("index_id = %q, want index-1", got)
+		}
+		if got := query.Get("created_at[gte]"); got != created["task-2"] {
+			t.Errorf("created_at[gte] = %q, want the oldest task's %q", got, created["task-2"])
+		}
+		// The pending tasks are spread over two pages, among other tasks.
+		page := models.ListResponse[models.Task]{PageInfo: &models.PageInfo{TotalPage: 2}}
+		if query.Get("page") == "2" {
+			page.PageInfo.Page = 2
+			page.Data = []models.Task{task("task-3", models.TaskStatusReady), task("other", models.TaskStatusReady)}
+		} else {
+			page.PageInfo.Page = 1
+			page.Data = []models.Task{task("task-1", models.TaskStatusReady), task("task-2", models.TaskStatusReady)}
+		}
+		writeJSON(w, page)
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	c := client.NewClient(&client.Options{BaseURL: server.URL, APIKey: "test-key"})
+	tasks := wrappers.NewTasksWrapper(c.Tasks)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	results, err := tasks.WaitForAll(ctx, []string{"task-1", "task-2", "task-3"}, &wrappers.WaitForManyOptions{
+		PollInterval: time.Millisecond,
+	})
+	if err != nil {
+		t.Fatalf("WaitForAll: %v", err)
+	}
+	for id, result := range results {
+		if result.Task == nil || result.Task.Status != models.TaskStatusReady {
+			t.Errorf("%s = %+v, want ready", id, result)
+		}
+	}
+	// Each task is retrieved once to learn its index; later rounds use the listing.
+	if got := retrieves.Load(); got != 3 {
+		t.Errorf("tasks retrieved %d times, want 3", got)
+	}
+}
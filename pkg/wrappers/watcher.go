@@ -0,0 +1,82 @@
+package wrappers
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/favourthemaster/twelvelabs-go-sdk/pkg/models"
+)
+
+// TransitionFunc is called when a watched task moves from one status to another.
+// The first status seen for a task is reported with an empty from status.
+type TransitionFunc func(task *models.Task, from, to models.TaskStatus)
+
+// TaskWatcher follows tasks until they finish and calls its transition hooks on
+// every status change, e.g. to mirror indexing progress into a database.
+// Hooks run one at a time, in the order the changes are observed.
+type TaskWatcher struct {
+	tasks   *TasksWrapper
+	options WaitForManyOptions
+	hooks   []TransitionFunc
+}
+
+// NewWatcher creates a TaskWatcher that polls with the given options. options.Events
+// is used by the watcher itself and must be left nil; nil options use the defaults.
+//
+// Example:
+//
+//	watcher := client.Tasks.NewWatcher(nil)
+//	watcher.OnTransition(func(task *models.Task, from, to models.TaskStatus) {
+//	    db.Exec("UPDATE videos SET status = $1 WHERE task_id = $2", to, task.ID)
+//	})
+//	results, err := watcher.Watch(ctx, taskIDs...)
+func (tw *TasksWrapper) NewWatcher(options *WaitForManyOptions) *TaskWatcher {
+	w := &TaskWatcher{tasks: tw}
+	if options != nil {
+		w.options = *options
+	}
+	w.options.Events = nil
+	return w
+}
+
+// OnTransition registers a hook called on every status change. Hooks are called
+// in the order they were registered. It returns the watcher for chaining.
+func (w *TaskWatcher) OnTransition(hook TransitionFunc) *TaskWatcher {
+	w.hooks = append(w.hooks, hook)
+	return w
+}
+
+// Watch follows the tasks until every one of them has finished or ctx is done,
+// calling the hooks for each status change. Transitions that go against the task
+// lifecycle, see models.TaskStatus.CanTransitionTo, are still reported but logged
+// as warnings. The results are those of WaitForAll.
+func (w *TaskWatcher) Watch(ctx context.Context, taskIDs ...string) (map[string]TaskWaitResult, error) {
+	events := make(chan TaskEvent)
+	options := w.options
+	options.Events = events
+
+	type outcome struct {
+		results map[string]TaskWaitResult
+		err     error
+	}
+	done := make(chan outcome, 1)
+	go func() {
+		results, err := w.tasks.WaitForAll(ctx, taskIDs, &options)
+		done <- outcome{results, err}
+		// WaitForAll leaves Events open; closing it here ends the loop below.
+		close(events)
+	}()
+
+	for event := range events {
+		from, to := event.PreviousStatus, event.Task.Status
+		if !from.CanTransitionTo(to) {
+			w.tasks.service.Client.Logger().Warn("unexpected task status transition",
+				slog.String("task_id", event.TaskID), slog.String("from", string(from)), slog.String("to", string(to)))
+		}
+		for _, hook := range w.hooks {
+			hook(event.Task, from, to)
+		}
+	}
+	result := <-done
+	return result.results, result.err
+}
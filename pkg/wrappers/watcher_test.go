@@ -0,0 +1,116 @@
+package wrappers_test
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/favourthemaster/twelvelabs-go-sdk/pkg/client"
+	"github.com/favourthemaster/twelvelabs-go-sdk/pkg/models"
+	"github.com/favourthemaster/twelvelabs-go-sdk/pkg/wrappers"
+)
+
+// statusServer serves each task with the next status of its sequence on every
+// retrieval, repeating the last one.
+func statusServer(t *testing.T, logger *slog.Logger, sequences map[string][]models.TaskStatus) *wrappers.TasksWrapper {
+	t.Helper()
+	var mu sync.Mutex
+	served := make(map[string]int)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id := r.URL.Path[len("/tasks/"):]
+		sequence, ok := sequences[id]
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		mu.Lock()
+		step := min(served[id], len(sequence)-1)
+		served[id]++
+		mu.Unlock()
+		writeJSON(w, models.Task{ID: id, Status: sequence[step]})
+	}))
+	t.Cleanup(server.Close)
+	c := client.NewClient(&client.Options{BaseURL: server.URL, APIKey: "test-key", Logger: logger})
+	return wrappers.NewTasksWrapper(c.Tasks)
+}
+
+func TestWatchReturnsWhenTasksFinish(t *testing.T) {
+	tasks := statusServer(t, nil, map[string][]models.TaskStatus{
+		"task-1": {models.TaskStatusIndexing, models.TaskStatusReady},
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	results, err := tasks.NewWatcher(&wrappers.WaitForManyOptions{PollInterval: time.Millisecond}).Watch(ctx, "task-1")
+	if err != nil {
+		t.Fatalf("Watch: %v", err)
+	}
+	if ctx.Err() != nil {
+		t.Fatal("Watch only returned when the context ended")
+	}
+	if result := results["task-1"]; result.Task == nil || result.Task.Status != models.TaskStatusReady {
+		t.Errorf("task-1 = %+v, want ready", result)
+	}
+}
+
+func TestWatchCallsHooksInOrder(t *testing.T) {
+	tasks := statusServer(t, nil, map[string][]models.TaskStatus{
+		"task-1": {models.TaskStatusValidating, models.TaskStatusIndexing, models.TaskStatusReady},
+	})
+
+	var calls []string
+	watcher := tasks.NewWatcher(&wrappers.WaitForManyOptions{PollInterval: time.Millisecond})
+	for _, name := range []string{"first", "second"} {
+		watcher.OnTransition(func(task *models.Task, from, to models.TaskStatus) {
+			calls = append(calls, fmt.Sprintf("%s %s: %q -> %q", name, task.ID, from, to))
+		})
+	}
+	if _, err := watcher.Watch(context.Background(), "task-1"); err != nil {
+		t.Fatalf("Watch: %v", err)
+	}
+
+	want := []string{
+		`first task-1: "" -> "validating"`,
+		`second task-1: "" -> "validating"`,
+		`first task-1: "validating" -> "indexing"`,
+		`second task-1: "validating" -> "indexing"`,
+		`first task-1: "indexing" -> "ready"`,
+		`second task-1: "indexing" -> "ready"`,
+	}
+	if strings.Join(calls, "\n") != strings.Join(want, "\n") {
+		t.Errorf("calls:\n%s\nwant:\n%s", strings.Join(calls, "\n"), strings.Join(want, "\n"))
+	}
+}
+
+func TestWatchWarnsOnInvalidTransition(t *testing.T) {
+	var logs bytes.Buffer
+	logger := slog.New(slog.NewTextHandler(&logs, &slog.HandlerOptions{Level: slog.LevelWarn}))
+	tasks := statusServer(t, logger, map[string][]models.TaskStatus{
+		"task-1": {models.TaskStatusIndexing, models.TaskStatusQueued, models.TaskStatusReady},
+	})
+
+	var transitions int
+	watcher := tasks.NewWatcher(&wrappers.WaitForManyOptions{PollInterval: time.Millisecond})
+	watcher.OnTransition(func(*models.Task, models.TaskStatus, models.TaskStatus) { transitions++ })
+	if _, err := watcher.Watch(context.Background(), "task-1"); err != nil {
+		t.Fatalf("Watch: %v", err)
+	}
+
+	if transitions != 3 {
+		t.Errorf("hooks called %d times, want 3: invalid transitions are still reported", transitions)
+	}
+	output := logs.String()
+	if got := strings.Count(output, "unexpected task status transition"); got != 1 {
+		t.Fatalf("logged %d warnings, want 1:\n%s", got, output)
+	}
+	if !strings.Contains(output, "from=indexing to=queued") {
+		t.Errorf("warning does not name the transition:\n%s", output)
+	}
+}
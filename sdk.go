@@ -39,7 +39,7 @@ Usage Example:
 	})
 
 	// Create multiple tasks (bulk operation)
-	tasks, err := client.Tasks.CreateBulk(&twelvelabs.CreateBulkRequest{
+	results, err := client.Tasks.CreateBulk(&twelvelabs.CreateBulkRequest{
 		IndexID: index.ID,
 		VideoURLs: []string{
 			"https://example.com/video1.mp4",
@@ -48,7 +48,12 @@ Usage Example:
 	})
 
 	// Wait for a task to complete
-	for _, task := range tasks {
+	for _, result := range results {
+		if result.Err != nil {
+			log.Printf("Error creating task for %s: %v", result.Source, result.Err)
+			continue
+		}
+		task := result.Task
 		completedTask, err := client.Tasks.WaitForDone(task.ID, &twelvelabs.WaitForDoneOptions{
 			SleepInterval: 10 * time.Second,
 			Callback: func(task *twelvelabs.Task) error {
@@ -95,12 +100,20 @@ import (
 // APIClient represents the main API client
 type APIClient = client.Client
 
+// RetryPolicy configures automatic retries with exponential backoff
+type RetryPolicy = client.RetryPolicy
+
+// Middleware wraps the HTTP transport used for every request
+type Middleware = client.Middleware
+
 // Service wrapper aliases for easier access
 type (
-	Tasks   = wrappers.TasksWrapper
-	Indexes = wrappers.IndexesWrapper
-	Search  = wrappers.SearchWrapper
-	Embed   = wrappers.EmbedWrapper
+	Tasks       = wrappers.TasksWrapper
+	Indexes     = wrappers.IndexesWrapper
+	IndexVideos = wrappers.IndexesVideosWrapper
+	Search      = wrappers.SearchWrapper
+	Embed       = wrappers.EmbedWrapper
+	Uploads     = wrappers.UploadsWrapper
 )
 
 // Version information
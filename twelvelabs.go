@@ -32,21 +32,27 @@
 package twelvelabs
 
 import (
+	"log/slog"
+	"net/http"
 	"os"
 	"time"
 
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/trace"
+
 	"github.com/favourthemaster/twelvelabs-go-sdk/pkg/client"
 	"github.com/favourthemaster/twelvelabs-go-sdk/pkg/errors"
 	"github.com/favourthemaster/twelvelabs-go-sdk/pkg/wrappers"
 )
 
 // TwelveLabs is the main client that provides access to all TwelveLabs API services.
-// It includes five core service areas:
+// It includes six core service areas:
 //   - Analyze: Video analysis, summarization, and gist generation
 //   - Search: Multi-modal video search capabilities
 //   - Embed: Embedding generation for text, images, videos, and audio
 //   - Tasks: Asynchronous video processing and upload management
-//   - Indexes: Video index creation and management
+//   - Indexes: Video index creation and management, and the videos of an index via Indexes.Videos
+//   - Uploads: Resumable chunked uploads of large media files
 type TwelveLabs struct {
 	client  *client.Client
 	options *Options
@@ -55,6 +61,7 @@ type TwelveLabs struct {
 	Search  *wrappers.SearchWrapper
 	Embed   *wrappers.EmbedWrapper
 	Analyze *wrappers.AnalyzeWrapper
+	Uploads *wrappers.UploadsWrapper
 }
 
 // Options represents configuration options for the TwelveLabs client.
@@ -66,6 +73,31 @@ type Options struct {
 	BaseURL string
 	// Timeout is the HTTP client timeout. If zero, uses a default timeout.
 	Timeout time.Duration
+	// Retry configures automatic retries for transient failures such as 429 and 5xx
+	// responses. If nil, requests are not retried. See client.DefaultRetryPolicy.
+	Retry *client.RetryPolicy
+	// RateLimits enables client-side throttling per endpoint family, e.g.
+	// {client.FamilySearch: {RequestsPerSecond: 5, MaxInFlight: 2}}.
+	RateLimits map[client.EndpointFamily]client.RateLimit
+	// HTTPClient is an optional pre-configured HTTP client. It is copied, never modified.
+	HTTPClient *http.Client
+	// Transport replaces the HTTP transport, e.g. to use a corporate proxy.
+	Transport http.RoundTripper
+	// Middlewares wrap every request in order; the first middleware is the outermost.
+	// See client.HeadersMiddleware, client.LoggingMiddleware and client.RetryMiddleware.
+	Middlewares []client.Middleware
+	// Logger receives the SDK's diagnostics such as retries and per-item bulk failures.
+	// If nil, the SDK writes nothing.
+	Logger *slog.Logger
+	// HTTPLog selects how much of each request and response is logged at debug level.
+	// Credentials such as the X-API-KEY header are always redacted.
+	HTTPLog client.HTTPLogMode
+	// TracerProvider enables OpenTelemetry spans for API calls and polling loops.
+	// If nil, tracing is disabled.
+	TracerProvider trace.TracerProvider
+	// MeterProvider enables OpenTelemetry metrics for request latency, errors and
+	// analyze output tokens. If nil, metrics are disabled.
+	MeterProvider metric.MeterProvider
 }
 
 // NewTwelveLabs creates a new TwelveLabs client with the provided options.
@@ -85,6 +117,7 @@ type Options struct {
 //	    APIKey: "your-api-key",
 //	    BaseURL: "https://api.twelvelabs.io",
 //	    Timeout: 30 * time.Second,
+//	    Retry:   client.DefaultRetryPolicy(),
 //	})
 func NewTwelveLabs(options *Options) (*TwelveLabs, error) {
 	if options == nil {
@@ -114,9 +147,18 @@ func NewTwelveLabs(options *Options) (*TwelveLabs, error) {
 	timeout := options.Timeout
 
 	clientOptions := &client.Options{
-		APIKey:  apiKey,
-		BaseURL: baseURL,
-		Timeout: timeout,
+		APIKey:         apiKey,
+		BaseURL:        baseURL,
+		Timeout:        timeout,
+		Retry:          options.Retry,
+		RateLimits:     options.RateLimits,
+		HTTPClient:     options.HTTPClient,
+		Transport:      options.Transport,
+		Middlewares:    options.Middlewares,
+		Logger:         options.Logger,
+		HTTPLog:        options.HTTPLog,
+		TracerProvider: options.TracerProvider,
+		MeterProvider:  options.MeterProvider,
 	}
 
 	apiClient := client.NewClient(clientOptions)
@@ -129,6 +171,7 @@ func NewTwelveLabs(options *Options) (*TwelveLabs, error) {
 		Search:  wrappers.NewSearchWrapper(apiClient.Search),
 		Embed:   wrappers.NewEmbedWrapper(apiClient.Embed),
 		Analyze: wrappers.NewAnalyzeWrapper(apiClient.Analyze),
+		Uploads: wrappers.NewUploadsWrapper(apiClient.Uploads),
 	}, nil
 }
 